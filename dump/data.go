@@ -0,0 +1,1357 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package dump
+
+// Version identifies the bundled API dump. It is the SHA-256 hash of the
+// dump in the JSON format.
+const Version = "bdd7574d57d069fda9f0110ca344342fa3b72e8b647367e3ddfec833a1c0c6b4"
+
+// data is the API dump, compressed with gzip, and encoded in base64.
+const data = `
+H4sIAAAAAAAC/+z9bXPjupX3jb7fn0LVL0+57pNkz5VTZ+q+ripZst1O7LZGUnfPnlOpu2BySUIM
+AdwAKFs7le9+ipT8LFKUBJIg+c+byaRtkwR+6wEL6+Ffv/R6vd6XgWDGkPnyn73/3y+9Xq/X6/V6
+/3r5b71er/fllpb3pN//yO4fff7PlwGzNFd6/eU/e1/OacFWXOkvZ7t/dvPnp+uIkp8eaRWRtuus
+n/7GlunP9XWw4Ct2LyjrJycUxJrb5B12v2Wv1+t9GRMLk7/3Tcmsv9Tr9Xpffmpu6eUHd/7cvzNf
+RHMm+B/MciXz32bA5I1KX2jGhKGz3J+csBU9/+RBL/SDiZi2C577Mq+bONJ8yS1f5a7R897cKyUy
+luiXAu9YgKkhs8wtT6kcpP8PcDoQpymb71YO71blTop1LjzKjikSPGCWwt0r8o+aeDZWczlvGtHJ
+XxwoY90AfaMCJr4b0i+/VoTuz78F1D+invODQ4o0eSgQXNqmSUN7VbvVcTHckx/0yk9opl4dMU3S
+dpylMlRntR5A6vAVofRaGstkQE3jdKzuhXq6UcEDhW5oHYl4zuVBDsCHX8G5qd5zUzlncdbys3it
+WvErD0OSrXYnmxgvCBAvwCHKS+/3Pc+XsQzSjd8b/yKm+0IMFlyEOlPhJN4vW5LNigfnLeiYbKxl
+iSu6Ujw8VDZehDRDsspf92zJr3qxXbnE3i72kIzVag22q1nuSy7DS66N7cuAjFX6hIXPfrlPj5XZ
+VvnVBu3bqaN37CA9n63v8/8FUn8KhnezvG92TOM+RxFIAkmlfy54sLg2fTAJJutmMj0CwFQfzuDZ
+scsxpBmLhU1eIT1O71uS5/fVCTSGr3xaw+xgDqS4cimGpwOr4hOPcHNgYmBiGi7SV2T71mp+H1uq
+RpDZnsfBsnz+qCut4qgIhj+Y5iwzoasRFA4WTM4pnPC5ZAJI+opkcgub/kYBKsfn/z0JNI/sdlMb
+TKfx5L6hsEYY8vQbmV43cNk9u8A8hPm7+39SYE0DF31I9/H8uuSg0RsX9j+Kuq8mUBHdkJzbhUeK
+OjN/2gct7S6JoQCxhVIKi+WOnmv1aNIktN1LVI9cmIBkyKRtnBHoa81O1f9Hb+cgNlYtb2I2scz6
+tKGXsRB5WV8+pxCcKqu1WZfnnLwa3PwoPx2wDuMBL98bNhHJnLfEj8lJQe6osbs2r+ky1UAevjhL
+5VC+LzB7YIC2i4zXQOGrC10Vhyw/WxEUdo7CMS3VijqaNny0XdtbeFH5Pk5wc1XjFfQLk0lhTTnr
+sS+6cdjlV+N0axOLBH4ybi+VrjDpMEgehbSQd8+3fEl3sfVoRUIVZ8ZyfRDMqtM8XB0v84s6f+Mk
+vDHXQdev8lrkegUdrnNs0TaGna6gbNFGzlDtgVRcpOJC0WesyByZVG3aTo6bSsTPXXJ91rT7TI6b
+JNwktVjDa9xReb2PF6ucrrEvSZepwtDPmW4VxsPboqJOjoRHee19262xy7sMKgb/h3Iu3NB2YNMr
+3euOZ/PWvtVchP0whF3rwkZvcqaw1e3e6tczdYWC3fFEYW+2PBXxxHph11u/6wGMd10bXWVw45ec
+N0ywUXr9dimfV+bjcn5eug//Pokj0uldQfJT//f//X+P7+6m/+f//J+PP5f5kUnF70ATsxljJ3Iq
+gv/xyw6JOX3m5Ql915MJaWkmavIbpnsxvAJj4iooM01xIT3VLHgg3T+fkrHJOAQu58lNRuzLxlzI
+eFmognvHFzR8i0Ykk4+509eS23SqBYXJS12HnuzNoWP0/vofTd+S5AUgKx5uzHNpA1K7dv+nMz1W
+PMDxVV1fKn2ywj6AyzjvWXWQmaPzGwdm452+pPbou7Rc7HL9zBsfAy75Cb2Z0poS40uexMuefzfY
+6UbudKF41Z3ce5yr9OrR5HnFJxqiPU7up3cp7Oy6tkgVqf+CgHw3wKIsLM7guFUnJq6DthsQJqRX
+fF/kNiu+e3zcdvdje3sGhJcU0327bP0oIqZ3fGyGFio6SbZvLQsWS5JJGfwj0yGGKDd8tPwhlSo/
+KLBK/1ru+OPy0R0pLm3HwU1+sOx58oegNbhMPJXmk2WgEKEQm4btmM8XFuAC3KaB+z0CtaA2k1rn
+Z80gULHVtPwcssk6ap5w1HPzwmRM8tO5b/vuu+p946/MHvCuhQ/yWdllXqVXTRbq8QcPSfVDlEYe
+prM8K43c7uJAKFNZaViY4CM72W6lVVmo/bDmYKYzBelkMVbJv4dDzeZz0uUb3V4x1zbxWhw7tRH/
+O63duLNFb0l773zbAtH7XnPiVQ5k3Z2T6y6FqoaZyJpybZ7jUornVUPrNbSghseavXgujKtkYm15
+YNricPRqM96SL1O7dx3iXsmveyUlLWUOjD5Zxl42PlvGMn6gUtf1+BoWwdZczl++Ik1Q6+R4yMod
+r0Tysug5ZMV7h7SAy3/cq85HD7h6mrZW3eFry0OiBioLZL3TNaiq3r3tpdmpxGBqJUS7gy379Gqz
+vbX0VrC2+8NkVe6kyA06VXvHWFhLv3JR6sW4e6CvzdZTA9BdANpV61lveb4hObcLwAyYP4AxE4rZ
+xtGsVEQhaD6UZmjGz+880lzlFT4izuiMpcL9OF6Oyc970zCmJhFBPcHYtsPYTvmSRsrw9h7vO5Qd
+3H5cf1JSfDGIdU4nf+hg6OAGQj1lek5gGkxXxPRx96z98J+xsbkusJsLrjejNP9cdIymyXmtV16q
+y4XL2bnsHcz/lyZnf9UE60a9+kfrY957NQ9XF0Nz//R//flPf/rTn/70pz8VXsUZCyk5xEDsIfav
+mVi3TD+QHhMLFhRO+FwygW6pHrJ4SHLi+Py/J4Hmkd3uZ/OoTPTU3ezvtJ7pbEwcA/mwfRoG95an
+JEMVfxpF4D+QSQ5EZV4RLLsbDwneZa+Hc2SvB4eyQk05sSqCpgSyTclEH/IwSaIpofCk5g97dp23
+Bzt40N0YL5vo36iUBu511g7sqthwW0Xqb/OefhSJ9d+SzqI/SKiAW06mGmleKqu0QYE4XDtUbaJq
+07kKQNVmQM073lH0QqGpauSxsAxnNk9t0UjEcy5rGl+COuK21BEX6CmTrLrSzWx3cUtmUdW0PyY4
+M7jD8nZi7VjdC/W0vZ+tczbqlJ5srAlcgkt/uPzKzHcZxfeCmwWFmzFk5lLpGy4fKJyoWAcEXlvB
+a06VZGNwvZaGtL1esnlVVBpDFsN8vTwL+YTkW33ZN2kL3s2LQXmCVI9JvVVhLAioAlXvUQWkgNRD
+SJNYE6A8EsqTm6rzdA9+crtIfK7ybs88n5wCeS0sryMWPFR2fIw2D8MBElhmfsNdRBLhNng4nqI5
+EqwqJqPkUdCVADLzG8Y002QWUJdQl17SufoQtZiqG2bs6Pl+7QdpU10+IZAFskVm1W5PREOyjAuD
+gxHY9IPN71HILPWF2AIKNIGmJ2j+4PSsNu/kT7o33CKiBDo9obMfht/o8dSDe6sS645u0fgbJxGa
+sqfRF93ZIQlKTCJnBocI6JtWs749NdcQ62lZzt8ZlACUQEOVwJiWakW4gwDorQc9adBZpWeXWLk9
+HS5rQL1GKwez//7xSoR9pJx9er6kxz4cItiJOu0EHKJa5R+iD9H/R7W9N27Z+pzexkJuVchnnFBN
+XnkLjoJBfuctORL/9JZJNic9Ib3iARXsznFcj76zX3aNWNrx2N6eMUX/8Kw7yHksQ/Gc7jBZy6Aa
+EbpPH4vQQgkt5oY83Xum102/ukvFb+Pe9U1laKY+Ho55755vaRmJ5724HsL/fdMJvGj775BMKk9+
+lWWhC82HdmItORq/0Z3XmxZ1+lomvrXS62qV6Zp0WxrVncG2wLbAtsC2dNu2XJHtb+6Hku5oddQQ
+99GJyuMG5S3DfLqIl/eScVGh34T71w+Pt8+bMOF/lOQ9FZhy+em1flBglf5LveN4v5wdAFWLshz8
+0ZrTOBLkONzWHi36LsbcNwgyI8jcQl8hjbgovXFNh9UQHiTP5Eq26NiMU99HxHNec0iRph23jLUK
+wtVzTs7Jur7J83FaotgmbOXmBq7J886q2stSsiRqTo+oIvvh7aJMLMsuP34vAyOtItLZrvbLXYoS
+Sn/ZD0sOvWNi4R6cer1e78tPzS1lj7bJp1ZzJvgfm8aPe2RJJjPdvvxnz+qYznJ/MFEA2x886HV+
+MBHTYWJd4AD+fk9+zVihE7RkySgNKWBroNQ5lPpRREzv0LAn8yQNtyCqfKIO8jlyZvR5rJyuBNME
+lIDS6Sh9ZX+ApO6RVJaZu5vNDFkA1WSgnB9u7VKZaEGaap3L+c7rJM1XFPYS79OtAPSfuOk4/jMm
+zGH874/P5BRqZJB6inAdcijZXCyXfCpxz+ngUmdnN0JR13TA3WxKw1C605ykzRtjAs0HzecNriNl
+OFgFq01gdazarFdTBGuD9SsPQ5JfzorifMrtfpuhL+0oNaFAyZDpNc5U0NbNCYH94Ibvvmrv4WhV
+XwwsZ5C8Y034U2lRhj5M/y50IXRhQ9FFyKlz8FYS0KqCXcS4oH2bTTDCXsC3XHxLAhcxsDpjYMm6
+3UmxRpysCUoeITNoescMH9+SIQfBisuQ3C1k6YVIXhYKFlIr2NPm7OnkZPHsHdAiJftJr4tYcSOQ
+X9F3vJ1Yu1JV4Bt8H863+9Rpy4LF8nOn/RpTp52fXKaaSTNTeokDC64GMpTQv888Y5VafZ1Vb1Tq
+8JgTwHYIdusva0F3ZXS7dojOP6P0wRV64zKd4Ay5eFUWPCRdcNvsudGTjXV24z4ku9XVKENakvVV
+fD6jf21pWWo7o6p7FpVQLxrkHIUhP+1NFnVO0jmXOfoBKLlD6ULGyyIUPe+IJ3dyQ25YTmJ6e1sD
++jEkbarmc0ETEhRY7IHbPSg0jHRIJl18CktY/k9h52q/bXL6l/WK33gsVWyoLbOiXG+za2f6q4oi
+0udc5nvSeT53tjeddcdRgS99Tgu24tn9Oo/sa8HkOQ11smY4lXbOqy6hQdqFTJwmsNQ5lsqqD73S
+PAJNneu7UyZOl0o/Mh3i/hwJv82BdqSQoQ5gGwTsmM8XFsgC2eYg+z0Cr+DVLa/lxG1umYyZSG9D
+UxJyMmRwSmrtmbsctsb0e8w1ma8sGTMJqhDJccPVVCkxRTDHN6CM1VzOfbl135o06uhEvuqzHIhh
+xau7eH7Gu4V36q8ktfDjLn6PeRSd9GU9JAx4v83fJZ2+0R4lQyQu1wF5EM1MqmdLDDL175LwXPPg
+YbMxdeXVXwo2z6d/l3x4k/1zpAOdXG2es3BO1diq2JDOrGg50VjVMKb/7NT1uE+WvkUL4pfDnxP9
+6NgA+yuyqZRfy5k6dYY98C4P7yut4qgI2kOe7jvTawCemnezrcAIQTYUtwOuz5rUm+na3NCcCaAP
+9LuG/ndD+iszOMPgDAN9AH3wTh9UeNKBUoBS6JRSKFncC13AbIQ8CWFWdtdGxrA5eYR1XkJGFYIO
+xQfF52tTgjtZvYaAPLx/fKCJWYUlgYo4WkW476AWzmlCesUDKrWD2tkvu67108f6eyV8RfYq5nf3
+/6TAmr7dN9rMrfZ88kso6tMR65YshFejSrZQO88Bda+gDI0EW5O+inmrejyWVS2wadHElbxesjlt
+9tlNitdOG1Uk5auYceu1OgVsX/7oq9F5tjhNawz5g7TB/MTDYWpiK++DQnRcWl+qZCZkk6j4VczH
+JENK40WVeHOU297plYXqXJnswGn29uxB+YD1mMe8HyotqTXp+icvyYwFpR2F97SS/fQu35ReMnEd
+YryTP31d2QNNAk0kzUKhs2ut3XXHFCgdnmY82r0Nrs+BA6Vp7wkw97B4eqDq7JdDvK5GjgkYxFqT
+tBs9c6c5SQxF786AnqIN5z/j0bCjakf4buQ0g4rhal4YDpSdcO6rIsx29GXTVEX3TKcj8iKmSQbr
+JvqXM6GYbVo7j4nj1e8VP/fb/U888fR/VHwoZxuzhST/X9DupGi6zmcWBwsm5xRO+FwyATBbO4aj
+4GVnSUfdzZ+s8UhbTme+kVYBGZOE/q9lFDtyxEYinnN50E3oh19p+xH3Kw9Dkg29tmricL4xGbIv
+euFOTiL2iMOt8wvZvbU+QBaxGMRiPIBroR6HtCKhomRU92dfCXS5p8sDlXV0CGZ7z7RnGpfbc1aw
+eeae1M1edbf/gzcvhCrBukJSY5pzY0lvqayGxej5r6X/A2r7XvKVyO7ZtxNXpUDy9qeXenkjROl8
+yQ95FtoJhLZ+oTUQWghtscsfCGvdwrpKDhrlrMe+VoKf3uUH05xJ3H7Veh+Lo5ijo9gZihcgnWUE
+SmAwTzCY/jTQzbV2neqitNW2NeRawPDA8Jx8THKdizKxTFvSddddVJ2DMlmwyHE2cN9aFiySK6g/
+4QLKnyTg120p92KzVKL+DKK6R1RZZQuYCOXfRKh0Tyb0e0yZ9ekeK6pBrFc04X8QLJ9nqRd5hRoN
+4Al2r3s8lWX29gSZQVRb81lLUFCXLKABW5JmwKlrOJWln274fGEvltwYJEjD7jnm6lrOxOZsAbDg
+oJ/M1ITmSfzDgCa/aOJNjUlN6cnGGtrJt6iUtNRwpG5Izu0CYMGfcsvVrQqhrvwpY3y7K03GahIR
+YlTQVu6wKtDrCFjV4l19i5MNbeyl308e2gUu/BBPcMUSLvtg9lwB9T93s5khdOPsBlHH94PcON35
+sLjJAh/SjMXCJg/+075k5+c3VHnvVUdqNBpGVj0bjdiy4Eg0n6YqXvKqKoeYnsd5VxEnSsnBZaXT
+OBIECamnpWruD4G75nZMPecyTLK3dm1wE/ThtVypB2hEHzVi4RLKnO9oSwHlgAlxz4KH/ZPmATSA
+rlb1Z6jaErS/i5dW4fpWrUi3apzxlWLCOC5xk/NYMP2DhAqyG2wjXlPPDcUPCqzSv5YbAiyBqlv2
+NFX69xgX9ODJBU8jcNS5m4kSMGIbY7eCsStrGMX7URNnXnT374KCXMLggmlHTJdxFss/ZnxsdfNy
+eGvkkepSaZT5wPF1wtIMLEGne6rTd+m5dmnywaVmy66Ln3c1UZtNaZomHwIjhDB6PURW26mVEFkF
+Rx1WS8EMnhIOKu3x1hBPBdKuTH0ZZ++rtVatPnrjuAS/xAFGt+wJ4Xiclno9nJaglfzBSBmOKfzQ
+So5wWsLI4azSLqQjaEggXTHSR49pvGHG5irgAuVYLguQ3K2mt8MxReeX/Gj53yvW1ZaMj4kFCwqn
+TM/JlrCbdVTMqXCd4eIj07FtJhqHGDcs3aiAweMDTr0eEmd7PZwewPO79xZQj0DaDdJluLvThY6N
+bbWzi6tEOCi9Hq4Sez1cJfqDERq3QCv1erhKhJsJpHe/N7p9AOmqkcZVYnHocZXo35KXESLoRO+P
+AdNWKXnJkn2BT965E97OONiJTN2yJwylA04Occr/s+CpvTzFWqIfAQJQ/p7W8/PAAJMzmAapx12A
+pHNmaMS0bShKmEMH7eQYqTELeWyAFNxxF0ilfxPl7qDJHU3ILcDRzgFLsZbQSyDJDUnQSRgFnf0y
+/XulrScXcI0d0VrRcGCs9QFrPevuWqNCtdhe1nAfP1bBA9nEfMfC1F6lepxkDYQydK7VoyH9k8tQ
+PXZPzsbqXqinSaB5ZF/+98p13EBF635sFwOlHjhdarXcbstUXcg5l4SNqWdjLpbcfl3fax5WOJh9
+qcJY0LfsdsCvluCoccFFdvHTSxmruZwfPTP47NRFoWT9sSbvnh8l2Jn2LAj0l3P99URBbOlvbMU2
+r1KNBvsnWzGT9zzACTi/3EUkXbmfB8AZawEqQWUeld9Y8sV3K9KCrauh0nIr4NtASiGlhaX0Jw0W
+zCbnZkQv6tyL7SKpqr3MgAlxz4KH6xB68/X5cRCQ8elQeK+UwBkZpsRX9TUhGQ7UcslkWJHeyn0Y
+mGwxk4Wuy15vAgYq4hSWeAng2Ze/iwak11Nhxz76JxfiG1vxObOEcEi5msgzDl7d58HWsa3KHEmb
+/XqAIP/vu6jt3yiACekV3zcP/vmPffypzJyQpMXIQBOz7H5nfOnL7sf2Pncnef/ZPiUy3LBYBovz
+WDxcL6PT0uAOkBtmDNnkVacq97F1SBCXFt6cPyeMhXp8pfMHJ4SqanG0XrbgkktuFlTRec/YbGeu
+1crB2/2fWKatL2cL5+7E62d2xaNwsGqDJEU1+aLBxh0eabXiIWm4Y8d0Ydsu4mYfkrpmTwzelVZx
+VMTYDXn6qUyvSzd5rkm+JbM4COKi6HdMI0yU4OGtCkl0fjV7xere8gT9yG5wlzo75ROFb/X0nthu
+SqkllAO2JO0apvRvTuL7f1KAfiYeNch58aIailSO4IAndzxdyHhZSD+9bkqpQJVg7pTSIZfMEuxe
+OQ23v/IwJJlLUcNbcldhnd2Tf8lJhHezvNgoNGlrG2aUwJMK0GCsg+cG9yR9JRbeJNXeaB/tmWLK
+Tiz1m6ZJwATOC7Byp9P0jZgeCSbpf9qJU3oCKIen/UeFZFHupFgXPyyUfQxoP9CJ/5/eXPI/CEh3
+AOnDex7/pWlMz3AUwey0Co9DJ4xO03MydhBbNZsN+c7MhkMu7nvFU6T4XCpNN9yUlERZYF8+vdNd
+ek9kWpJHmecOtKgf3QG4j5jMnQrlcy6mi92sZ821NXf3JnkVOT+5IWDvkGJhY0eKS1tSLei+zKJP
+L9TXmq3rq4uFxi15smTux3gtpGOSIen81BuPR3rm+lVer7sSAsaouvWecmHhAVS56NfSko6UqKy4
+lWQ4UsYfC5enmwoYuDMH65F3M9rBFQljzXLQPXFFjqrPyRHtAiuCLui9Xq/n56lzxOR3ya2pRvnF
+OY9qdd0ZqK6U6kmgiWR6uJ6qcVUNE59ao7FPtmFrLMWQZiwWNnmDP+1bjRfTT5FdwO6XcQYfs3XT
+ziYTspvE8RGTSdVXuVrsDa9pmQQPilK7zH63E6Hdk1//6U3eLxcse43kXi/ZnJK6QNIntpfoHTSn
+IqmNVDpszXESHTdKgPPEuOYBRGolRF/OvWqkjdN8vdoxifLi0N0C+HLSvTt46H7OlMSxG8duHLtx
+7G7qsfun0iKcqjcxxGr02GPy3LznnYjiMYeXTYLzr/6yWLh90jSOBDUUxXeWFTACxlpg/B+lltXA
+F+Znevd6MMn1HVs8aJt5ybWxI9JGyalm0vA8fF2n71hKW4G3ZJrN0a1Eb1TAxPe0SXgtDLwmbnEl
+HXTP9WiS+862UC7afDamVyeTYwpV0zp0nmjVRyKec1lfXHyz8N8lFr7ihfeI9Qpj7/WvuiH7k62j
+vBIcrH9p6z8heyGThrId7XlfndsGEPeB+KwGqiFRZlcxtX22EVDMfXuPnK8WrHqhc+TdqUeNAwT/
+MV/NtF34PdrzEwUNe17KnjsPpCyYnNNXbqzS65pHz3g1WWbBNAss6X4UEdNMlrwsVU99yPysE/sm
+Ja0SB0oojd5Jxqs+rueaBw+bnSm1FVfpYP2KJpx+NQjebEojobqhme3rJRQWFFapbEFnQWe55OqG
+5tBZ0FmlsgWdBZ3liqsxny/gaEFplQ8XtBa0llOw4GpBa5UOF7QWtJYrsKZKGwWVBZVVIlnQV43W
+V64vS89VuE5fweyZsr/jUrWJA+7PmaEpPdlY0zUGDHo2E45L+9f/aNq4oUSCkjELgMmbgeQvW9Iw
+lG7JLKCVoJUcoHS3Ii3YGqauFVCVliKXaBwfHb+yzkE4AuEI9OnxQtnF56L3AnLQ7XzREZPWTGkZ
+5Y0XgUjVJFLSkrS1yVTKxh6Beha75krAZMG1hQRAArLYaL0EwJ/CFZhToK40ixY8AFFQph+V6W40
+Wn1cnTxwiftg/+ThiOtg5yLxwGWJJ9asBtwVnFfPacFWPJv5I69p4vt7QYMF29eXBSJVT0Q0p2+j
+I5+lHLCShd+25U7oqhwr2w8CMobfC8ouPgdnJXN2XEeUPGDcNmqImLZ3+sUklNOvIZ2sdUivhpfK
++9pmCizJGDZvUcMiF6MFzkVMRacLBDnu6YnLd/B8tAWzOU5ZgcXD9B8XGi1tngu1BrUGtQa15lqt
++TFl74rsZKFiEX43dBOzE/049Mo/YSuu5Uo9pAf7ARPingUP1ZieYPu0AnM+qlQUb9+qNvPzvDZ9
+PY+XJMsa0LevhfYhrbR9UKPtnrAypjk3ljRE1UdR3bOLJ67PMQORXt4Ibk9tpzkmkyEoCa19s5ZB
+RSNdDenr0K+ZrlnJuo3DsM6prr9xEqHxZaLrFm5TC92XWi1bQ/iZmzWZKsg8ZL5Umb/kwpKepLGo
+CmV+E/yaqs3jEZV7jd8KtiZdnjJsYOh2syRT1ZYF8UsV5gHfZWV4qfS5ViwMmLHQidCJUAFdVAGj
+FD04Rr4ogeeFuVQauqC1uiDnPbPScN1rjEKjhZJQiaWwutwOnAqQ0NHrIUMjXwFV0jDC1jVb6syH
+ad/vVmuZLYnH1lHG2ih9HWR7bChLaETlmy+dpNhTP7B8lW7SkO8UTaDlHq2DnM+ZUMyWXYpQyK+7
+VbGhgeBVJWRsTjc/Fyp9JoWdPNyUd71ffM+/qhXpC5l99i5r49MHY+Pr3fgbYivCxndl49O+9VD1
+3dv4Jfbcgz0/Okx4RBDQfY88HjwMyVJglS4YCKh5CrSIk2TjEQseyA5YsKBOxy+Oux7qh+GUzatR
+GlzmnlIbpyxODnDazJU/cS28H6uPXOwd73xFSYxZUO4PO66bKCA0YPC4wOLd/T8psKauq8fqLhYP
+4PtZ5/bDkMIJn8uqysihasvCfHz+39sC0c12Nk/pPkM5pqVaAUtg6QeWUzafUwgOez14ATXxZ3Aw
+bHYZeV9rtm4ae1+ZQUziSPQQk6hUEKsu0quhpUPiE0McIY4IEdZ9yXdtaZkGTiCLpdPXxDhcYYi2
+gQ5g1G6MnF+4vlxO7L74rOy+1dl1qpNFkTM+jzXb4U2VcG/eq7MReppw3NIk4xkTpliWcfqTB73Q
+fulOVuVOinWu35KdUrBPDTW/s3/fWhYs8joNHsv0yx/+E7LnS8+e32eud2xL47n6M7jqIFdljuMD
+UE2fFeXRdBsMS+rosKSyVNQPno4qAlANBqqEU7KxmnFZbo155YPXBZ/LO81JWpZ3m3KkC5n89Rwj
+BFFyJ0p7GkLs2JNSdfNALSPB3evmpGpczmPB9A8SKuB2DbY6UTJeEVxTpX+Poa+6x1S5ljD5WqbX
+/SducqKk4Ku9Z5VS8RoTS6+1NtoLB+KOQlaWXRyTiZQ0fEWSjAFXMI5u8eJzHnK7ht5C3OXjw/eQ
+9yH68iZKU1HsZKQMLyFwEkVi3bcDkpb03eyWQevCmruC65Y9XSqNXnsInLhECuG4bnuH5RjC54Nt
+qrDgH8IS4lwLzdUMzYVDLQ61eYfaDOzqPNHmX34deZTFba3HmX8/0m6Jv5arZcvACle13bXaZfCE
+G7S2GW2P0BIs+cKpAk/+pMEFNmZW6TebU5s3mOsh1ekPnjMhJirpTuxWIm4SmTdQsh1VspeaB+4P
+3Mm1xvYPt9kzLLPMtw0RnZJqQsYs5DFChN07bGxslfO4s+U2ztOBIApEHUDU9JEbC7eq22fXVwbK
+gEs9ku7LOeoiuxhxKxWt71EEtGAN3VEFoNoBlOtI22s8q2g5d6Xhtq9cuh7a/RzmRBG2l9Hn8uuw
+k46DqpQb/UlEOGV00GDfKqt0KUQhR6SzUA1J85yGvke2Gou1Jmlb7AgWvww4GKrWt/ysgOoSHDrE
++Dod4yvjtIzIHrw5pxfxyZ9M+jQFAQnSDNdcIMstWcj87Wq9DrI7AFQP2R0gyluiSgi4pn8SZg9M
+OWRqyvScLE59MHu9Hi7dAVTG/+Lg0j0Ngnp5337DJeV2QkLzrl4Pgdozj9i6livShia/x0zTDXsE
+WADLCVi3bC65jUMY7y56gyUhhT6DIArd4GABM+FyfdbI4q7OU0aZqU1DnjuaHNlNyG4qT8mPlWOo
+b0jO7QIKHde6joCaLnjwgNaeiEi+f/pYhV7GI+EpwFNopX4fq4jgKkCte4kS0nXgesL1BFMVuZ6R
+n3fhE8FD0ig+R/F5Q6o6901twIEGB5rSwC5DW6KyE5WdZVR2pn8YPKH+7nSeUNkJssolCwlDKEjo
+9RDYA1Ee1kw91+FBSSFW7JQr/gdwgoZyQRJ6MgIllwXC7Y6ygqmKXfK0RhgBKYQNHPGEjrG4ZP/8
+9OR2hst50u39iLv2s1+KXZclV2ADTcwmFzbvv/Uf5d/XD9aCy5DKacSMi/uOX9yXShcuOjt90fmO
+glIAQ+C301G67XgKDL4AWGWAhfkXYKtRmZKYf4E0yXqoLucYcS0DwWXKCdoNdtoUYxoGuGqIi/eS
+OvkcYUMGJTjDiAyg1ZQkpbGyG5friZsf3PB7GMjOxm4x4wB6qzloYdQBuNKYeACu/I/Y/lRahG89
+LYRtOxC2HTLLclJteu8z3gKr9K+15VZtQrqaB6xwbtXudKwTUqQcfMZIc7Nklge1fUSv3uPcQHGB
+3kQwWy4vGtvclA8my+8CmEhzOXdL9ZAto5w/CiUJmg6g6VIToWspgHIGFNKoOx2KLwMp9DZAtadb
+nGDxwJM7nrgET0hrcJvWwEIeIxAGl9wFTBPLZzM0fYeC6vUwSABMZf4vLnocpOrLy1EC+SlcR5pp
+EizZmKmCGHjXj+DN5pSqYssAC/mGFUHVpCSHnVDUqVI3C1LCRO1+FIl13w5IWtJ3s1sGP6N7oeTS
+6EIwufm61S+i4Ae2yw90bbmzwat1sC+zzK0cnDND37VArhVyrd6DYWwa1iw3h9A5zmP6PSZj/yum
+mNrbDR1cH8/1O0XtCur3sF7GMsirx3/JdmUiiAWz9C1eTjVnSQGQuZa3ZBaTtQyyfn3ENFuSzTIj
++a/66SWWZBbX4Zez/J/euyFHb8xBiid7r/L/JQu2MdlYy1po26+YvozVvVBPk0DzyL7875VTekX2
+knFB4Va3mhO4dLkPV1rFUSGvUmu2bvoujDSJRPlXohMCJS1JW5ZaKHDK/KwrN6/UErWwUjw8nsgc
+d6SA/R5SpCnPMFfO9oTsnmOIW7zjzAfB3tUK9o0KmPhuSNenZ3N8MvhjvR78sY+ewNF6+DdOIjS+
+6OCtf9E3lR08XpyMG25sObzvcxEPcRULoH527JIMacZiYZM3kHyvbXpZQCbEPQse9mxxDc7ayxvB
+qJ3qrR2oJZxX6W+EdKTVaseg+g+h8Oe/duTkk7NfdkXHVjzY+U85IaaSpqYcp1jPuQz7uT/pVq2y
+9Fnp/9caH+Ls1EWZbTdrqpLtaJGiPHllgkT8aKriYHEeW6ukR9RkJyxUsTJcRrFNvtZ44pxM40gQ
+TGrmNUvZLvKrJu/bkeYqz/2HTodOh073TqdHW7G9oRX5FAPkJ0S2Yeh6PRi6cgzdVF3vgwOmrteD
+qev1YOr8MnXQ6ri/rdherJilim5wDekXy3Sp9PbppQXB9yQwf3q/72/fr9b7hO/yQapHWfRO4YHW
+AxX6uKR/37wZFEitbuFAaUI0G+7gzveCO9jrwR3s9eAOnp5WUrN2R4Qbeh56HhHuXg8R7l4Pxq/l
+xm/AhNj3szB4pcupsdkRpIrDDS8RnEn6TvWqrrv7f1JQUo7sQGS3atn5Ri9Zft6qr8KVYTlquDm6
+65I/O+0bV+hSxTKc8LlkAqqsNrllb3YEggu/Y/c3XJHtC3GeSOxGhK/lTDWtvnbI01dnet20iPab
+5X8NfXR1C7yRCHfi0HEjBhXhjkdn+gFMQme6Z/R5cGJSQD1VSlwHJwUyanPqcrtOeaskJmSHZFIK
+EECq89QV7t0FqMxaj121yef1ks0JkllfHDNn/SGT3ZTJkTIcBrNWsYzyt6CG5ILvQ778C+SyTrmc
+citgK+sTSpuz/g1cD8jkqTL5Xd6jiwg49IhDlPqg1KfXQ6lPuwVdiI3NMU0MJTd33VFkBWPv5818
+XjoToKwfyn05YQVzwbxra3kk2hcrknZv4dNrukk/DCmsEu+8BzaQb9T1lF4LlmSfTDPapTpYmYMr
+WfZlUVSxNtwkHlNbUDnISFTnJhyqSwcLJufVatP8R3ZSn6YrgvD6jkVpmRJtidYY01KtqtUa+Y/s
+ooDA1+j14Gv4rjWS4o03RVa5v4PQRJtJeMl6vvg95lFUlfmw+594Igiel/iVFy8/bNu/S6p84/c+
+s/NbX8oomqetzt89GKayeTR+j5upMkx/X2KY7tB74+1313ewZKg8wIVur8ZLsxZJo1/sZZ+yfE8m
+AH5QfbXgB/jgicATyRLHFrX/n8PH6fXg4zSE6mI3Uum+VnqF3VWsa4okaSVEyfOMnQWJHHzy13jJ
+pOJh0U9/83PlRLfevn3/KXu62HvhHWkVkc5uSv5SxGjp0xfuIi5HjY6JhXv0Wq/X6335qbmlbHjz
+1afmTPA/NqUXe5S6vFHpC82YMHSW+5MTtqLnnzzohfbr6WRV7qRY59qT7Mnc+5TBDyZiKtHEzYRi
+GX2o/32ChSkX5ulCK5tTkQuewbNbno/wmPpP3FSb8vfEDa7fq3KZJg/M0r1iumIPwsGr/6AFDwQ1
+7r1fX6Tmy86zXw5Rif+oe9XUAyfTlvthJyuiacSCBzYnU9d6VIdQr4QbvE1y0yUXNGJ2UY152/Sx
+Q+dwH5tMFkx5K0OQtw9dy6AzRqEMie5by4IFhWMKiK+Sp/pR4F6gYdbLZ2yGgpgG9pdKT0XVqFFJ
+j3lPO1GRHnVSyDmzdfEirNpj6rPAD5SUFNiqDqt6+1jkita35UNuAux6qzOEY2PVchcUJ7tFWfeT
+/yj/diTxCRzfjqhYBy0NJ1sdF4smJz940OscEct11Y6jxokCp/pqtbkmeaFxPw1V+kMVOyaU82Kd
+t0+lb3b1Lgn2u6b93tjcVJtWek3W7QNwvU7oeLf/D2f0XRhrNjNk4YyW7oweElv8QYFV+tdyM2VK
+ONgETOBcA5QcoPSDtKWngRJKA6hGA+XawCfveqtCErdkFlVfv31T9lyrR+NdgvE5rYqsSO7iOVqW
+s2o8pv2LNlqsDQ/MiGmbvW5CBQ/71+11eWt/48FacBmSPuSlu+naJp9+HcJ8eGU+0jYi0jbNH5nS
+k401AaeG41SCQk4S0w61vE1VpznbAglwJwF7CgY/b0ldzvgkooCzAo7ni5A0kfztXdyLCLuTgp2p
+jEWkolgOZA/VQjv+84/qBXVi45Cr9wA1LbhHxiRNaUOQD/JdZnxXexG4S5f371Vsp2pzMVjNvWBY
+wJa88lFJ14HCOip75/L/pSHtbHcRcvqdcUM+/uWr03hfVbfkc7akiU1Gz0AafAXiJxfinIZkrFZr
+gNEIMEq7e9k6g83o39Ir5jKf04KtePZ943Fucz+2asksD8Zk9dqN75x23k4G3x7kOH/+rSZHVfb7
+zB77wzmtvhyd/8qB+YbmLFh/Y0su55NgQUsC0NUBvbdJW6toPjpzPjFSE5s9JcZ1hieme30YhD4X
+6p6JonPQTaAizJj1d8ZsHSJ8lRLkQpCx8oet/J0OSVMIHQodCh3aaEke0+8xGXseh3Oyl0pv//+8
+u0vX5df7Hlhx5OZFqb1dinY0guDSfa1lGcGjdPnROqf0VB32dG1paZCq05UzOJfWlyN4PwwT+Kox
+Mjz7SSdal+rraV34jX/+U1GfUfAZWe6V3x2q+FPg/4D1Q2+mU0V3QnYT4t1nQNwKMcnEZoednOrs
+OYcFYuxlQ8lgT2BPYE8aNvTK+emR7jU3bR44/I4M0jOllzs+5sST4UsGR9enWZRwNmx4ki4vuyqv
+NKif5X6gYmkBNsCuGOwLrZV2PHXo2myz+ywLHqaaBVzOL3LPSYjqtTabqzTN+Td1D6UJpVmH0iyN
+6ZFga9LAGli3xRcYU6S0nahYhj+ZllzOcbPXPR9AqxkXjnXlpo7kB2mTE4iFtkTdbnlcn5MMFkum
+H5Inue3Zw4OH1A0YaQq4obsVac1DNDDxp4HJyxZN2DJKOoTYhQrray1I9/F8QtbusLAVhJp3PrdX
+XtvBXm39VJQMuW2txWmuF1OFvneP07VBYKyjTrF7mG64xKR3dLCpPG7gnmRu0uaIT3ZIEcmQpD3X
+xB4ixSX6/TdZU5bi9s5JZ+LR7L4O7iVrs2Btv5BD4KQdJfH9MHyWcMxOz/gPihFLBHCjJV+UZhOn
+GTY+iTrpi7FVAhi2Xtmqj8nES+oe8DVVr1iKriVWu7rVvostlrvC5V6RxnpX0fRyayr7YVhVp8sw
+30Vvt9vsyXaPaalW2HA/NrysKNctk2xOutQQVwdaTFw8RXpnE9hde4xYcoWxpwMvccuSs5/MBkXn
+MzZRAJ47rGIkklcZRUPOhJq/25yG3dsNlFyRNulWDflOOwTAataxOTP0/QXrSqnwfk2DheIB9YOc
+bwVXyJg5FKuN4gVQyOg7Halr+d0gC+vgLCxop10occuZGGm1jJD2BO3kAKlRrCNl4Dv5duh73pem
+jRZXEiz5xtI0e4X8BUnzJPCGmAFiBq6ZupvNDMF9Kp+oQ5KiflBglf7Vo9FL29Gpmw4cyDOrbOkn
+tF3za/PdnJZi2St+gx2lj+x8t9TXepu8tT9xPdDF+JQUonLn6BaWUj6XTGz8y00QfkKCgsqmLENe
+371B+GYjUKzQNaEtlhgGWYWseiGrdSTM7brQa1MGD+7D6zjh4j4cQOHG6dAGkCZS0oApMOWMqaQe
+FTwhgfvT44fZvna13t9xUZYhNwHT4UXIramy34Ep51xSIHp9WBQ7m6T8f0EUoXda+b1ms2Sag40N
+2nB4yGTh/IDXfRyokFpAZqIqlQaVPlJZ6eWlH0SOyaRTwnOtKkw4THi1WE4W6nHIZzPTnzMujT1n
+hgAn4PQSzmRuJWngCTy9wHOglktucSQHk5lMHt0p9DdOIjSF5xJXdNDvZPJjmzf2exQyS1N1wywZ
+u2diEpQZlJnHzBfKfdrY7G2ga8HknMIqcUfq08ttUE7I+MTl2BPz/PQuhWKfrhWAbzmByRpU2Biw
+wwFTH3e+0h6B2Huv9h7GsMMEbG/JbrixIKCTBGyOgFAC8Iib5xE7TxJLg1zJNUPxLDGHo1J3PbZX
+T5vdI4fyPHEzVpbZku8QhzRjsbDJI/97n8Q8vxt74p5IV7JKiDLVVlZ+q2JDQ/VYUZxzmTxuxDQs
+3UsVYDKM707elrswx4SAc3tPVLI0TCMkDmX1QVndqhVVqKzGbO0PgsnLAL9a8fseYTzPLqLcHDjm
+xcd2+OTqT0iGaXxhSDPSuqqggWV6TtajbjR5ZU0FVNTJHgMle7AdiY1leb8s6f+DNXk5AYchT0Sb
+ib6el+RlXmkVRweFmniqbpiGmfco8fZZuV8vlxRyZkmsod+h36Hfod+h39ug3+34/L9zrweh1qHW
+odah1qHWG6nWJ1YTW0K5Q7lDuUO5Q7lXoNxd3w1sAjByTsbuTg2qLCOp+XO9zwUzdpSM9o412qFi
+hIwjosYs5LEBT+DpZJ6GZKxW678luVEbrEakg5wADegCXYXpuniKhEpquzHX36uxfO/3pWkTQ5Xh
+eWEJ8NSuAWrl0vSDG777HAKYWjer4Ig6ta+8oruqCLn6b0fU5I5dPXFBjgrZ5ThgBVbE9/k0Lx5B
+i0fUXDLM8fXICf2m9JKJ67C23vwJD1nRy7JjnN+UPdfq0aT/XnWgsx9FxHSOfj1SwAZKJB4gRMwn
+v3y7KaW65WUBdaMCJqaaSRMxTTJY36qQzzjpdjI2Y8IUgyz9yYNeaH+7tK88DEnmIpVdD56hlk+B
+3LfwWFmQTxZcrjuuNMvg+T2tOa8xpEgTmHbKdERBLJgG1sC6RVhP6cniJt8/D1faJJLXTKTeeLfg
+qsl3rgeGJ640ixY8MLt1ypsL+oCJ/OjEuzhGc6MHd7OZITuxcWi+QxI6l31QAVY/gBWwcoRVCtSI
+9JQLgroCV2VwBX0Fh/DTO2T883uXcOM1NvWS9joE+M29pvUkseta3sXIYvaHo81+NAyiG5rZMZ8v
+AJI/IL3uScNgmqroXFmrloDJG5he96S2LCRi+/25ExOQnCUYOfjer0rs+diMFcn+1qybrpq/9FZZ
+pQttrxdfXJtevOSCcurzD1SLIxHPudwzPfC9gvzwK0j0SdbyTop18WSgBt5D5/Vg8NeJSIRlwv+A
+sEBYqhQWLu1f/6NpsgI5abacdEECjp6Mfs6TTjnb3BfTxFb5uQZ4v9julbAKNiGxxs9bsNkQbMTx
+A7v3Gr86tnhKy0hpptfXoSd76y43zoWQOY+HcFFHNVbFg+7KdXzSmh9EGTtXh1VCBxeZyAzuYzvR
+HqFclL4Ss6gHKbkepGuVHiUEDShQMkyPVjCiMKJ+xaGg+aD5SsPUAFMUbJ4Ms/t4QOHkiHqv+y8F
+m08sk2Fb2mY7WZP1IyW5WiWtiY/fPJhcvXayL/Td+1apWd//TclXfdf1xbhUIqx50C76ZyJAVLHJ
+VjqgS04ibOCE6SuyI8HsTOnlpeYkQ1/uVvfNU3n5gr7WbO147sjR3u9vCQam7Pu8Qq1ct9v5PQpz
+PHHHXV1n6TNz1O7rUlYzZCcHjmwNkf8v//B3mM1myzHH5kQjPyST7hy6rntn6MvKYj3OeF5orXS5
+mnVIMxYLmzxtn0p9fqslGcPmLZr/1qYxZxkNrksG9ZJxQSFIBam+kzrKbt4PTsGpV5xCo4JU/0n9
+ybQEp+D0ZE6dRyu2ADMxJWNdhysqrhk+0ooItib9lZny3Z7nR0bpIzF6afsGc7akZPGvQ490UU7x
+VeNUUc7VTFFVdGo0/sxpDY5rNXi1JbBrYVuu0xGKnIzb6G3/Zdj/QGm6loGIQxpyXfUE6WJ3AD3E
+dqvsUHAnBZeOe1iex/f3ggYLZm/4jCxfIj2gew1SywXrlj1t/h+MwfcMLS4bCFaC1FdurNIYwgCe
+3PA0CbQS4obk3C4AVcegmqhYhs6LugVPRvSmfxuFuajXODzA0BS6fygRL1GMBLjrOLlcyyi2bun+
+ynT4yDTdqtjgMNy5Zgol6Mtb9vReZRpoS2jL6v3cNJrreGLXirTmIU0s05Z0/iMQq+71EKs+HrYx
+RUrb/n1sCEEgnNcdkjVRM5v4fLl/GkC11ef7wUNSrmttQ1IDFiWzAhD/AVdOufqvmImcSk8AVfls
+mrfbMiFruZybZrI1pkDpMPEPndB1QIVyb5dX76E/37H+WOWoySNqwt/jOVgwOacx/R5/zr8tKftU
+7xGN1/2uLtsye3eydyn/XzwuEU+yDV+0a+Wphjuf+/y751o9Gu8GeCULFjF0uXqzIqSWZPW68WvR
+c53Ofyc33Taq0aUPtG5P/czJifwBE+KeBQ/lLEmBrq2f3uiFGm8T+Q/pRTs+/++NsRooKSnnw8pP
+6/dycEbfrGUAwfcP8sKNpH4wzZm0dVFdTeuookRfy0DTkiS4rtqgvamm/XPRctqQhGUerV3mBQYU
+QlMVwpiWakXQBoC6RVBP4LfVdmBbJUFXT9pE5gqFD+JddfeHlsj3JhwDEa9JxK1m0qSthfP368S1
+aWdwprBFn8aRoIbIu/NYtFD3TCQATKzSHZikeXR8aqK0pbBCVchMQLJNV30nq8OIzSln6lDTDuon
+r8eSyx8tcgJPXw/29ANOcXELua/dVME2U603knc6JE1hQSu5x6R6ZyxdeBFKzQX1JRNrywMzUHLG
+57FmO0wrbrnTyy4hOJkKnYl5orzQow26sfyL3AtJS8ANuFsJd+oEXsuZAt64y2kn3VVq7tiQBtn+
+DXVrdkwzWaSa842bdXyL+Tkz5a5V86ea9e+NErGlkTK8vaPN0sqi2mqQklW5kyK3PKvaOqVDUnx/
+pA2Z/9K0Qf7PYI+VZQC7K2D71karPK4n2VdGYBrKukyob1Twuqlu4Y6t2v51dIjrXFeH8rgCU6Xp
+y688DEkW15boJVsp+WOl7Nu/Ps0440O1ulWthS8jPm9OUdJKCpj8JdyTCbA7rnJSBkAJRf692hz0
+IEevQMRaq8NLIEkGC6VHiksLnErHqQMxsXMWPCT3rjIcKKE0vGF4wwejf6558LDBp9n0/wqd6pVO
+3W5KY6GaJmVNEdMkAzSQ7N6ovxLQUkmGNiw1LHXnLPUr+bDSsNKugLpVIeIy/rR2frMpjcQpuYEf
+8ScSYKpjgzLOacFWXGm3VA0Ej8yQ0gJpJi2GOncuhFwOV0PN5nNc+x0OlIOeo7jtKJ6jwdYqtmlV
+MkCFQXVA1Dd6shMSm7bEQ/UowZU/eQ9XMb+7/ycFzSfrhma4nwVZJZA15vMF0AJaJaD1PQJXnePK
+vdPe7rLBxkbpvw/5snHZSe2u1MPleaUwbQwdol5dDKP2o4iY3lHW74QpruT1ks1pa7BBF7yok8FC
+aRo8KEccDZQ0VjPUDPiU6vBhYxqGFTJbvcpERA+NU4H+wQ3HyQA1eg5Q+p9rGdITSOrAhfVxDSin
+j0RyX5jUbf9JkuGeB75ap8pG6+Q41Nmbs4ej3kFzM+9iW3RyJjHD5XzINZU4oGiP8/jppS4+vFSd
+a/lfMQsPW8yJXQvyaSE3L9SIQa6WL30aD5Pj81W0cqkbXnT11Iq05iF1fuLQmwWUXHw5wwj9Iieo
+0gcz5ri9RZsa1+PV5IUtnXs0k9JGZMGbqc8Iw5vpwZtxtnTwZmpZQXgz8Gba4c3090ZO4NiUOuwS
+oateD85erwdnL28h4ezB2atrBeHswdnz0tm7WFF2ztPbWvhzmvOK3DsuueVMtM2lOQhCdxPY9lbb
+V8/SxKooorAamp78GilY3ylh3ZKFgBxtGtNHsT2nOatMKUf7j1PHEbQvVX3Ht+eNIi4doRo2erBg
+ck4htrr9W30hQ2x0yzf6VsWGLqQlXc1GwweCD1Qv7DfEVgTYAXsXYL9VK5xuAXs3YP+5IBLJQIdH
+pgE9oO8O9JdKg3kw3wHmX3p4XDEuT3JtfP/AG2Vs+z5vquJgcaPkfKTJmBO+7wA5tckzn+/wTDlC
+e6VVHB0isH2t2bo+3WUss57kxHw3pNN44yR9py5osVQKRkyC/7r4t8oykTbGEMyvq/3c2aRVLM2K
+hAq4XWNJoC090pZcBgvoy9okIGACiaAV6cgmrghUZO0qcqxy9gA6snQR0PkNkKEmsSJQk/Wryckj
+jyrSkiZ5lGe1XJP371SbJMg42b67WbonZBBLr0capiyCx+DJZv+S83eSDVZ6/e5rY/7hW3e00v7w
+A5M4Ih2kKZGbHztnhv7y8R4lOwk96WU60MSyWvMn/36u1aNJ//39B/2yg+p//bKD4t2Prqclc07t
+KNpDVt+J+TJRSTn1s/8uU6rSh++VqI897BtEez+26jy2Vsl0Tjy4R4PdU5G6VSHDhHWAdLolThMj
+KARLYOlkluDVeeXVbXyOw9y6Mk/G/cDyFbOVVr/lzqM68VxcfQ3cyeGhQPDgYaBiaREZqiezeCOU
+fx4kG9G+RMS3n5g3Wx6506WIN3Kn60X+ewTgAXx3gP9L+83YX2DGINUdk2qYMQDvBfBOr243cBe9
+aGrH1W06k9ttlO9r0n419+8i1FfLcOaBkpbKnqNbAlBgCSw5ZSm9Zf8VRHlGVLopjQRqTIG9m80M
+YXq8X1DlljA2gaq8YXFgCkwdylRaex0xTTJYgyu/UjpyamR8xsokC9/WBKF07kghnNKfPOiF9rcc
+T1blTop1Lj3KjikSPK8zeWtzlErgOe2QQyEOnTh0ukJqklTx53wkeKo+9+11TxpHk+ABDSiveTh4
+qkU/jd8VoDQJp0lOnxHQhPPAATxNuSBELbzTTTmjy0ot3ksJK3izuv2x8m89p/Rk3VJ/qSRivx7V
+q6qyjwnlIATFWUoc5X2M5OyEGW/1oJxi0TCcb7ikr8TnC+jF7vmV7msHb1SQbBGFeaR2JdZ8MFL7
+VeRXHoaU1yup6cFoYzWX86Zp0TEPFu0lHuXXVbIEjvzjqJlaKfmL5yqWocGtbxdufb1LjykHaXRf
+Kgfo/b6ljwf0Q6A/12nbhASfpnKPfOju5UOXg9Mlt/ALkA3WliNTejeO/mc4gLuhCTc8XQyIlwST
+1eoB5Wxw39xDhUIR6CvnaAEqQFUCVLEMciZwAajKc1bebUsDkfqpWdRxnLqShtWJQ2fCc4QYBmIY
+bnD6777gc7kkpDx7ZnPfbEwDsfoNWPmJ1W8lYvUel8tY5s1sfDOVxKY3TVot01maFc3Sy8a+jkaM
+eblD2VuT/y9ZntWYbKxlibZwpXh4qAy+KIMvY3Uv1NMk0DyyL/97mWVNaQZUVVVNpza2vGH3JMrt
+a9mCvpXo+ICOD05Zwr0M2gw6BQptBtESDm0GwRTaDIIrtBlsE01ILO1am0H0hENPOMdNvNATDj3h
+HOKEnnBw3no99ITr9dATroSecMUuJTY/hY5w4P1E3w4d4ZCKmHmcRUe4Xg8d4Xo9eJWl4YSOcOgI
+h45wvR46wrVUh6IjHDjqrlZCRzh0hKs1kwEd4dARDh3hnHKP1FW0FOn10BEuDyb4BegIB+1YlnZE
+Rziw1NaAODrCwX1rFFTI6Ye+Qkc4QNVDRzgAhY5wncMJHeFadOhERzjEMBzihI5w6AiHjnDoCOcC
+K3SEQ0e4hnaE86umaRJoJUQChFPF3L83SsSWfnIZqsf23l7UmnyNjK+68D5X1qolmgF2tBlgSVQN
+mFwxM1KG5zkzAAvq6iiwkEPQ8OJ6n5C6EMxYHpzTgq24QkMZf47eH3emiXR9VZr/oaRlYvOAc6av
+JVqa+tUH693GNJGyWx7ChYcL77Zj37NUoLl3h1PsKqELaVGdTosqm7HpggcPkowBW36xxRttG7mc
+D7mmACEuD935d5vTaMYuZDJyCLlLnctdKgmsqYpwVsRZ0SVSP0hbHiDEhRBXhYzhdtE7zLL3yJO0
+uYGgRAaS12RiL6gFkufanS5W8m5ckZ2wZSQofN6SHyRUwO3akx1xd6vemD3ZCMVUTVUEsXCTRXkt
+jWUyIJOVS/miiC6ThS06ubb8jEr3TWxT/Zukjt7JSxXECMh17lBbAlOxNqrl3mCZfZcaH+R1X4yz
+CbmNiQnLl3TJhaWk0uArl64ae+20NEWAK2aiei0um0VB7Il0Y1QLRrU4QAhJuBjVkoUFRrVALzYl
++8T9ieSWyZiJ9IA7JkHMEJzGmvVox5zGEpiOheWJnoSGRMjmVJjuVqQFW39jyUfm9i+AgoSCbIYP
+ORIsoIUSIWkky6MfrXuqML2qm9Or3JvfzS3s32mdIzuwvG2xvEWDN++pwJhIKMhGeHLu1eOExKZu
+YGKZtrhQ7tyFcglILdSj89MutFRztBSG2eI40OthmC2G1tV/IMAwWyQoYJhtr4dhtu0Fv4vRZ/fH
+lgSni5DbJBsWQOHM0uthOHI2S/AzOxYoTHBO4zm4R6kFeT/vUd5TgXnfsPcNUZCY942sasz7BlSY
+9w20oK+coAWoABXmfWPeN+Z9e48T5n236NCJed+IYTjECfO+Me8b874x79sFVkc2LmWRjTXlNs1r
+b1/G3byWvebXJl1uCpu44Dmm1tsF3zbLOBXy3v6B9kOasVjYNAyQ+P37htq/JPjG90tu8535XsVT
+8LN3Oltd5f8LtMRB0Bqyf6f1vWI6vFUhoW1uTZ2Lyab5RVotc4ts3GiQ56fabM+wDmWQV4HQMXVQ
+LpUXqxy3/bUfWhCbG2UqYpGkJT3SZEx7DNTZqYvC01ScBbMDlvhyz1tiylmggWB7//S713tuDl6N
+yJZnLovLQyn+dM0ftlGFW9FLLNCdnASaSD47BvV8sutG9ZtKsSd/OtT/4CEpt4GZa5NEL9oaO0aO
+stfh6xJ4vlEKNyEdvAkpAaWRYOuccUlgCa34/AB1TEaJGONAUNFeU0V7CUxP+ZJuSM7tAkzDM/0A
+R6jipES3iUxjjGcHvYqG8pr7JwFqe+dml8GSEvESOdzdKAo47kZzlNxYINunosUWbI21ruCuZMjD
+JCZZzZXoKkdxn3jV5/39fM37fCHDk676sMtN2OX8yzJsc0u2OXVFsM2t32bB1thmL7b5wNyNq5hn
+ZW2kh9fLZOv8SdzoRxExnSY+OT1V95f3HPU06H7pgqVBrDVJO2BL0gzV0Q1v37YvM/N12zf7XSqs
+10s2d6z50j+Jzkgd1X5lAYUGNp1uYFOWm3aTzFpHg/5u6qpSoRpyTQHyCbwDa5N2VZwsl8dfeoyU
+tp6dgN2fVy5k0qcfqd4YgXgySmlR852cROwRihQ8nczT/1zLkJ7OacFWHD6fR91uPmxMSdZ5b2Hh
+DVunY/NF6iXsNdLnzNBfPhq67IhTEmkaaGJZc2ySfz/X6tGwT0mJ/2iiI9APcnQJpAx6uzhJodKS
+gFL5KBUOT+c3DPAYJfHI1uZOTlUEnLqmmfZ5fkde0AkemSGZgGTIZFvHioGrGi59h3yrZVFrh1q7
+yq9d3GP9zPONeiR9k3wtlGXnbvPKw2piCV4dgHIH1Pcogp4CVq6wunhKakTN3WxmCEQ1+2bYR6R+
+Ki3CScQChMoAl6tUlms5EzFJMAUT6ACpW/bU7qAGeKqUp7RUTE/VVx5S0vEWUOGO6FSmMCvcOwfq
++5Av/9JEjnDW89Ydbx5PNg4RPMD5rgygEDroZsXC3pzIcy5EOjtiR23D+4zID9mTTUxZnHIrIACe
+nUvzOmJU3w3vnMtwqgZCndQTr3fQcEDJRdHRgLP8zzixo0kBxfXpjV4WFtPA6moqeEV2TIIln3ir
+YrO32XHFjQbdue4FdmAk4jmXNU1i2zx8qNl8qFXeaHi3PY7C5IHZxvJEpXClVRwdohGGPKWW6XX1
+bY482f4LaUlj+7u6/Tc0s9j7bu79rVpB8Lu1+T+5DNVjOnZyO1y87EmMvnxu/R/qOh6yeeChwRCX
+NaJZGemNLBL9qoz9ycM52Z/MjMlYpTH0soQpLF2aeela5IcqeNgwWlD4M3/MO7l3sDr/1dKl6dWY
+fB4Jtr7TIaFHhWexaC4blyF1PZdK01XMryUujlH07IiqtIL+bjZQms5F7EhPjdW9UE+TQPPIvvxm
+Ecx2/mLbC1i/8jAkCT/t5eGTQBPJCu+pHbzzVcxvGZf5b5z5YdlO05AiTW3xiNBJCH0Vej10Eur1
+kCWOTkLQTPVrpgn/I8lJctvzhckVMyg+6Ga6OHpTQVM1w/BdIkncp96535ReMnEdorgcQDWqGLgM
+H2rEn0iYEemktAVMgSkHTG3+5q0KoaT8sXqTWM9YkFxrvdmehlnAqVJiFMtgMV1oFc8XaIyBCWeO
+h1H9D8qFm0+U83uyF93pWUFnWVKAEX+l3kAXTinM+cGs+8oM7E+R1ENCf+eaBw8bfBqp/zGJF9Mt
+3fqsmMSLBm4ugfrBDb9H04VGX0yUkBSVzFb7NSw0gu3ITPLmp0UN1oFAgzGoYlc8oX+mX5lRiXIb
+MW0b2VbsnOwjkZzSk401IfEAMU1XeG2RAlGeHWqlJdlspJB7h1atjoBS4AiO1KkU/SChguwaOLCE
+011xmH5yTWMW8hi+OG6D3576hVI6gaNQsO3XsHi4rV01iKgca3zlmGvRef6BlI3l585Yp4tQrRHr
+sk5bN1zSdMGDB0kGxgiBIVdcbfOakO2DbJ9uZvu8FQAk/SDpxzFXyP3BGTIjo5iSHGGu5Ll6yveB
+P7vMOAxCCBwcBkc/aj8Ojn609CCI5inI/PM6tj24TJqJgyS/PN7NpjRwaOvzsCTkVmLWpiuu/uda
+hvQEljrQz/mIkRnpWLbz2Fol/zxUj7LkqRlux9Ad/IXfo5Z+XzrWq6XfdkNsRfV8m+uD2lcmQ1H0
+1i7nVNeZ4xoSIzEX+sPzz9XTQWL08YcbOayIkp5lEARk452MEjLxcIvy8ekDJalzOrUv56j+hkqF
+dQZKHs3MkpI0TDR4csQTUIK39/Hpg7XgMiTdOY/vesnm8Pg6WL2NuCQKtktXq6l26ZxOvSE5twuI
+AnzVk1FCZRbc1V0ahncwOIlzGwTh49Mn0YJ0B+P0GPGdLyaHl1zC/djx0ufgDJxVwNkAnIGzXhUu
+pHQV7QRrYK3Kozt4azxv7zm6jGVapb23ETiXYdIv8U4med33SrAT8r6z3+7Tc/lcKk3bcdUkrZmy
+7NEQz//5sndXMqVvT0z70/vd3f+TAmu+5P7Gv3857F+y8uLHZGMtD0PuSqs4KoLbNI7EwUGG1zz9
+YgLuvsB6S+MRmfvN7ZaFrkboatRDVyN0NUJXI3Q1QqQ/h67SuhptQv4+ORtoaYS29ZX427ZpLY2c
+lQebXbPlPsh83vp0p5fTE8Y7eeaWpVvSsr4WveJxJPbETTlRowsZLw+JGPWTN6kkXNSW9h7YZu+3
+eajZHDt8wA6fnboOetuQLK9Y9sQFKXIuO+R8VsWyhCQsy83Ra96idK7nERR+61s/YYtP3WLXR9y+
+Dran3CKZq5ujcBPPppcswOHUs8PpZk+aeWVg12hWUgFOe3T1x0jdZlu6G+9IrrH8MJrflF4ycR0i
+5oGt7vUQ98Aun37I59uxHjjht/+ED/XWiVM+trniXtctP+ZPmZ6T3SZ04XzmzfksX7qqSOHaIPGS
+yXVIPkcjO1THSyYVDyED/qRxvexJ7XmMN8wYhdF0+SKUZtxBfJAF+Ul8krcoIEL54pYtR1nlI82U
+IsUlxAgjQ3YLUgIHJKlgem1s1WYhrmJ+IRMrC/cOM1NP5mqgNF3MZhTYSyVC0ugZkA+dD8WnZZeV
+FnbKtsg0EPmrmIN38N4l3r+xFZ+nlMB9gPvgiC1wBa5KmQ5hblXIxJAzoebtBKpeG5ysyp0U66a1
+POkA+T+5DNWjAfWgvjPU35KMr81dRLLr2B/sPpRBPXg+kedNqJbC5Oy16RfYzUBDrU3dPI0iXMV8
+i0RDoXYJNI55niN1XO/YfhgOKEkYzj/Buc0nDfOe9ao3jsoo3bcVn17m+R60vpT54M0GFOhxW1Gq
+7eDjW9W2PmahHvfwfOLaHNNd+OWNaluXBQ+phetSetvlg5zaVXYqoLvOy+Ur+b/Tuhrd/pD5oBNZ
+PKr0x1jN5Rwo+oTi2wS9nGIXt1ia54em/0NrAD1zti65m9E4n6xNguu2wOwYQd2MH4Cc+iCnJbXb
+2TeK4tML5YykgHxWJJ8RBZyJ5rt2hx5N33w4APTFszvXioUBM/absnyWxHJzfslxPCk7gtpFWyHf
+bEB58aSjloZLC5H1RmQHgpi+0Fqd0i4CO3DSDihD19JEFNjkar17+1CP95Su+8QymzZRCO/ktYxi
+O9ko7mqG5yUPRKSs8kyExqiGS6UDmpK8VMpeJ1cxec0yHLNp0oeHHuGZvaONg7MNduuK7HZOXXxS
+BLe2Tch0RBu1B6kZM3Z7SatGyvDqTl1R/tNquHrMrZj2QU8UzhPJD4A3itGLpbJkEuc2L2vUZ11x
+mjdRj4ObLHxysBuokDxZ86JdqAZKyk2sO/2CNohA8h23ZAybUxMFIO9scPRGHJ0tujedv7YtzrFX
+ELaqduKKLSkSbD1isaHwbTB6T9kjTJD7vYj5tTTkywmhqnn7ta33m0giYK968b99uHa54aZx4A95
++rVMr1tgCcZklIiTH54ETBDCFHVtxITN6H+UpLvZzJA1EIoa9+I7x0Gk5l3YWumRYGvSl1otn1vO
+Dsmkr1ZZCG9R+MEnRvMaWJUjW5WAiCtj51L73ZC+DqsR1DjvWXXgyKX963+ARt9o/MntYmCfAGUd
+FiPIXHgYjFpF1BO3z2yaME81Cx5IdzZA48tuOMytQajsgJVProHPtXo0pDcdtCqyVlpAOzdGO7fo
+djDh/RtL1vJuRVqwiqp1LLcCFXSQf8h/zfI/pqVaEbrsoKLbLw94wyUag4BGf2h8aTmQdzmHlgNd
+Q7Seg+oWSVTZg0Fv1OTEYUJ975CKsJxn1aEcUQ9Wq2qclJtnewCZlPswoNlJNIW6Z8JB8vEBHD79
+uSVtNk4OtK2xEs9M/AUrsWXiL2hCA482S187LVvowXfo9eA7HMvi/gE3bhlULTpXHW0mhjRjsbDp
+rcX2v54VW74lyRhxO1iYPVLtrgLlANG2KvIIy5lQrEYP8F5Zq5ZYj+fHC5pZrMbz4zWfL9qzHFDh
+JahwF4VrBxC5NB7N4nn59noG8bxxz2hPU5lPrx7v2bZeDx5ajeJ9owImktqRGmV7oR7RihOtOP21
+PlM1nwu6jIUwgaZmNjFrSdfJSboBr50dPNmKAt0KP3Qp/IsvCbO/cRKhKTtZ9mKVM+rpzQiB16qQ
+tLdlGeVRbcg9LrScH5uLj+n3mErpSVOdqij04e8zZAYLJucUIlHmIIehd7Ar4RsEb5ouVoqApMcC
+DR/b7lh2Rst+vrzc0jbhc8kEbjI7pneKdPc53RL30OQHTX5aLTbvGppUKjDoa9Lroa+JhzLyd1qP
+NBlDYcNLURpZQL5UIZ/xZGkhDB4IQ+JwVxIjcpuKVvjbkjhCG79t0woj3bn2R6K+kX1U+mFTI/P+
+eIiDYccU1occtNMxaMp3L9TjDbEVDZSccb1kJd3b+PbZL+Wz7fDZaigfhsvWLg34ksZUqQlEJtfn
+15D0+J3jgqIa2RgwIe5Z8LA/yVGGA6XpO69+hD66xX1YD3pCSliTU5F+yfnUL5tGvG8/4Pkq4qOn
+8Jp7FPMJ6RX/1Cf2yySOSAfp3cebP/TxpzLvNb98U3agidnkoPbl8wJ82f3Y518dUyT4jtvQf/yy
+Y1H/9csONfX5tdwsVzimOTdWr2teN2fL0ishn+1WWaUrTHP1yBNKblleXqk2Rb/i9zq1s3k7UfHS
+/Hj/Tt5q/XbP07s26fJP4ihS2laWQAYphZSiofxhgvqyFRDW04UVQLpoIwG/DhajJIvhbm1+MBFT
+ScHxfc7hIU5ix0IcGVrL9Rn9K4ssDxDVOGDFVBSR9mipsnLhfQtzDFUQL0naVOt81xVlxUdMW87E
+d0z+wEDePYD2zVoG1VDJIv5dixGzC9y3vG0tMTys81ekucopJKrYtZsutLJWcDkfPb9Xg9ZyYW20
+TbH35yDx9cNLQWM2tfj7UD2cdFqozEnYqGOoYqhiqGKoYqjizdqMlIFPXHMOUsgsw3LALpWzlv1o
+E2LjSv7N7C8JfykGVdKStP+P9co4DTZvVXt7RBj7Xg/GvrHGHgcv2HvY+14P9h72vteDvT9oPWHv
+m2Lvt7td4fleb554l3af8iXhZMjT1WJ6DSMHIwcjByMHI9c6I3eTLCeFsHWwdbB1sHWwdV21dWlS
+dVW2zXnicbKl90/9iKOYOoOPQfLBAqNZahriZJmuqD1vkN9O5URlW2CSy6c3elkkFK/U1p/jjdEr
+VTdWoQA/8pglV+9FdaRVRDr7APd2pfaMWX+znzmAjYmFu2fI5UD2U3NLBSfP5SOnORP8j02PpD2C
+IG9U+qpWx3SW+4MTtqLtDx70OmklXT1lsDWk6UrSzNLV9+thuSr/jWOe7EhRr/xRs+haDmIt1uea
+BWQw4d9bj7yOJPMCGtBnf7ENIxWvyCa6vz/PaRvp8x60o/Dtb5O7b0MKssf5YtJqMxos/WCaM2mb
+posT/i5kK/g7OCKfu2U+wNcBX2B7YLyWlnRls9pU2y6GSidx32CxggPFGhRNc+Wj9jApojshtZKh
+/K5FS2w1+nY09uheYR5HjOKE9yG4GROmcAxOqoAFC2qLOTm5uGNBLCTti9eHw0dtqQHtKtyGkkQF
+V6+HJDV/DXGgllEygAyWGJYYljj/fVBl1c0AY+F7ln0f04lE1A6koL5dk7/Fy6g3IWu5nBu3qVj9
+2KrkzztNx8rhq/cuA2t3EKeHpKtyk67e3WwoabUS7qkaK8ssAaiuAXWVPMk5TcnM5mASsKT2Cbqq
+o2id04KtuNJu8TrXxB7+pri05k4OKbsDIMhqLVnuc9wHbEmabSbtt5OnNAZUCKj0J8smqkDZzGvI
+gwKr9K9N1FYDJQQ3XMmcby0LrJGI51x2tVpiT7z29YAaL5lUPHy/Uw1TX0NuIsHWQ745Q9cAWxet
+4qGI7dqlZoL2jS0BmGduV24o3efww6VQSt8ym24XnK9Dna/9IeBkVe6kyC2zzJmhlhENrkJzvlDR
+sBjIV2Ki8wfTMlCuFtOD9O9MKGabZs83oH5wTGDZ/bLszScLBxIvDySf96hpdpZHX4nPFxZwdU5l
+lXIWSS7X4bZ1yW0r/7aixHyQ5E9DAXZUAZbM1Ug9kgZWOAqcTNMNzewNzWFXndvVrzwMSRaP6+X8
+4JAiTdXY58Ll+efM0Ihp27RjyS17QgSwo+qzFJomQkXUl3OBKAoOum6gUisack25lSm4dGvTpZt3
+KVfuPc3k/+IyAyeYMri6CwIRm9Zqy0ZeZLzfl+ZZ4ZFgdqb0cmKZDGGFhfFLVzW1RiKpJOaazDcK
+HtpJFaojqjR/Yz7HDb6XN/jPO9M8oBYICSMk7GtIuATilbKjnJFHXXHyDlaiTYy0eEZzKUeXCTEA
+3ZnYYSeI5oC5g0fwUliaMj0nO0raFICpLpaTu/cgp0obhQMTDkw+GuQScxG/G0I6IvpTOePpJxMP
+k4jQkQqpNO6AmiqchSty9bpwFN0iheNDV48P7s3eEjnJFZW3+XFA8MH0HtcHvR+G/SAgY5IvqaQP
+OtvzuFcUjmqBvs9ifXqf/LGE2TuT/y8NHWZXz+iwfhgOYmNVkpplY1MNhybvWSdCiBl2p41BP60L
+/17FXwfgVaD9ZgrNSPEDJvqUKQuHzvLZLhTEoH1icB5zkWRRXWq17FvLgsWSpD1FKGBmD1j+QTLz
+casjOrjofgzqHSyYnNMkbwCEc2Ow5/z4yRT4MtVtm3eZLtZJc92gO07F9uL3mEdTpSoaw2+zn9Tr
+4XzYMfiS0dLbqAEnXyxX4bFkfa3ZuolLnkwHpXBIJjWZOb9U8coXvpTIF12vF/9chevkPmX85/9V
+jcaNsq/UoHGPrRx5u4vNY/CGL+8BX2PhS7evedSNBFtzOe9LvkwvpaaaBQ8w+hWt/qkH0zpAL3hO
+9H/d90zvc3//gYO+79HfuliMTTcPWi2Ktn9lBrequFVtNeC4VS0oBrhVba0YJEl5L6elinK49jyu
+18OhvWMxy6THZTXwLQt00zwRwAIpyoelKhdA8MyBfUrzbYuaJ02CJRp3qjYDmT1y2rIVfK+H+7ZK
+ZHmqqpFmoQIGQf4oyJKLL2eInUN0DxXdMSXm0b/b8o4sPAIuCLi096S5YRwxl4KSgJhLiyUhEiyg
+yrN07rcP9APwvektBSg/Q+5Ii0W+csGckB0IHjxMVVLWX+mFOuU+7EQWEUZpfunHBGkejtI8ziCr
+rZXVeuzGlD3QkC3ZvKJLDLZUsbQe8ZfTlQIAVgDgd0nPJVaI2VW05gI357g5b3GUwsKowai1tKNK
+FIm1m9LEAwBfbE8w+x8MPd5Y2H/jJELjK+gDwUnaCekVaUAP6PNjXC0RgqQm8GKpbEV+DCWP+pbd
+FfREznGtXudloqdkn6+vQ9B9JN1nTtbk2qd4MJf2r/8BcW+oYbtYUXY38RfX7jkWlCgBCisOQKV1
+9Z105MoLPBba9oHgy/tE21Vz/5czfaPlIZm69/lNLmg/DKu88O1sQmgTQ14Hw7RJwwROwOlonIb8
+JH481biXTAgu50P1WNVtXlCA3nZmkdS91Zpou93Y6nZv9dVm0uH3CBvd7o3ejEzaNMiuyLtZ5E1p
+6vVwPCpvt5M5o9Derd/mTS35JZfcLKqSak0syH4YtrvE7R4JZmdKJydVGUK8W7/fYzYPlRDY5pZv
+cywlQtat3+YJ5QzVhDCXeQMdxFqniV3Mjrpa0Vo3/ZZZqvT4qUSI0rwPryLpsdNdqX0Qgm2FaqWy
+gELVXa/CzUWHS1U9kIXW3N373L2mtfesLbuwB0O1MKTZDKG09lubR75cIsjS+n2eqjio7CrEJg/j
+ct6mmMKZq4o2BFqyJeCXnL+TUK/0+u0HP7//x4/+dKb6+O+TOCIdpMu1cxne7Pa/ftkherulJENa
+375xgR6574V7pFVEOrv24KUrHgsenv/4+sv+HchG68uYWPhl/wDvLz81t5S9mb3cSgvNmeB/bJpS
+7Kn/kMmsiS//2bM6prPcH5ywFW1/8KDX+cFETCWWouRlj/77BDvw9oUmARPkmCkVrpPfyf3TYKoe
+pnJcF1dI7e1ScxxWaSnK3r8NrurhKqcE0GNVNaTILqCnuqmnEjvVS9xqxz7VJQuAU/fUU2k+esIT
+fPQO++glOVRJSQr8KSgs9wpLK2mhsTqssQZC2YVrrK40ixY8mE4WXFtQBX3lCqyvjGuoqy47WOWR
+BTvYZbDKCjF8TWgAT12zgClOAyWULoGn9O8CqtKhKjDm9/XOQ4nM6b4eh9UTnBBV72ZUvRSc+Hxh
+ARSukx1CdR0KQvSzw9GEcrBKOoUAq4676CWc+G5oZvt6CaJw6HOMFM593Tz3lampbmgOoqCpHCMF
+TdVNTVXa9cw3QvUDMqvcHwK/xclvpaNyTLIP5Oiy5oDpIu9hKzZT3jl8MyZMMfrSnzzohfaXRn/l
+YUjyy0HFZhVY4QZm3oyYzPYSoR9RcXEASlpFSic7hHg+yi5ckjVOLokQIsPBswSmcPJEjMw5VwiS
+QVeVwBR0VUejZOXEMsaxxH12d9VVKfEEVPCggscxUCoWIWmDaD6i+a4tYNLrECYQKV2uD4FTpY0C
+TzgBOgUKxz8kSTil6ifjBlWs8Kuc+1U/mXiAX4XbZZdI8RD9/Lp0sfwek8tYBnnK5Pll+mGYJmdV
+09ZcsmXWk07s3H1U8/o8i5G9JXv4OWA5mDFkr31q55+j6wosyEH5ZWOysZYlytkqaVt+oCIqa8JA
+UXG8IrvJlTxBHl0u+JVWcVRksYc8/T6m101c8t9jHkUUNnPp+1qzxq36ZrYSTA80bU0AThxo2gPw
+o7xnnQjgPk1xsLIGgBUB6MruHAJikWdWDWSOCQOLZQ+22pBQ6dBYSY8XXVaIde/4Ox1Q+c53XAPV
+OMVrSCYtfPtsnuob6JXcHrkeZSKy/2Szo55llhGWfPP3gwJb+tWfe5j+TuuBCgk4VYDTnjnFn/ak
+YSiNlOHtveCDasp/oWSotuM7vu+G9LWMYpv7t4FVDSrqw9Y0TFO9vH2OBIGrWrlK/7wnl8jX5laF
+fMZJ/53WQ/UoqzlLLl8fWs4pcs+mfHqhN6vQkmBW9hT/f/tzuk3l4e7+nxTYgqfas1+KNVD58k3Z
+gSZm2b348CX/KP9kfE4LtuLZKazH6fa+EOpxIDhJey0NaXurQhLoaRK7brrzTdlNm6HP8OyT67JT
+kHLE2lVmd3nsbqi91EQgtzRyxxQJHjBLYS5Pyp5r9WhSwnN+bkiRps1f64AcHJmJF0VaraifmxPm
+2INCAprnN4FHi/BeiauV7x+kDVeyUsz3PRO0g3Z3tG+clGrw5ruPNY7AHqSnpwOg/nx3CK5bw3Xi
+nd6ogIlUj1eovkfMLpC/WRjxfVJbUFqLIF6so24VYI5Y8MDmVCGasRblQFng+urTywyUtJTVVdcH
+KA+5k9vE80zTsbwie84MbVeBd7O64Gj79xsnEZqTIgt17fiELPb6iL32ZxcHSgjK/WG3tiTYbhlO
+qEC7ZLST4PmQAiYqKjowxHSwmNKTRfXvy/MjNqdv8dKjFeHSQtRbKOr592QQdYg6RL0Fon7DLBn7
+9oKlb9YywFWil5A760HUPdCTJEgnEZUD+I6TxEvgjQCTLwJwYoAJ6AN9j7R6cn1T9ZUimPbyPrGV
+WG8dcuQ7AfLWQS6gu4G1t964+2qjJLNvQnrFAyq13ujsl12lBOljnZUiOVkQS1oykaSfMC5J17Uo
+Z7/sqWRoSQFXkKOfUJV7Qt1Lsip3Uqz3lLxkEdXOapZyK80Hf0L5ll8DOi4TX61xGP0ZGHUOo3LM
+64VM/IwQPPk1NaGJxi2ZlAb7Vj5IhQ+4SWJusilNBAkWDiCdDFLUXpDqPTp+5WFIsvjB0Y9mCeXx
+fmCQabRYGx6YXaNFX97hb4pLmxU9anSjH/dynuxXX84x/KvZw79KkKLhWrIlD8ZqR8vID1KUK3BV
+i5Kbr9989ij/u98vkSfv/KOid65NZV3ipBSjYbcDjnBQAkcuOPoLOAJHDjj6FRw1mqMSXLorEZfj
+e2fFDur3vG+ZjJmYxHrGAjrgKN/CQ8hmKfYzkLNknnzDTxJhHd9QmzIfxFqTtAitlBNF9Ti5pqSp
+7WVPwjJcUwhcGx8J9ASoW/b0g4QKuF2Dp07wdPRw2yK6x21hwIp9dqk+G6HqygJydiR7Z/L/pV1T
+bcvwS5VV+pATRfleaF/yZWby+gm6eLDgIvyWPdYe9/mVpoKfNXISSF5nHFduSFkCMGLJWQwSAAnw
+XQLcO+JTzaSZKb0E+vUnc5XNc4mJ92W5YH8d7okLJj/kS25FRf6im1eeSBb55eAizIo4g+fmEoFQ
+AOUUqK8KIPlUkJHuB4LpQKkOneS6o8YzFVUGM904p/sTAqpwTo+7sxgIYjp5vf7Mkr5Vq02+gi9T
+POoP15d8Z5Tm6xA2oLYNmJB9t/rX+XMFHd/e/VNxeY1JhmAyl8kp03Oy1RGZ+zzw2D0eF+pxRHrJ
+jeH3AgYqhy8HjvBmeWvutXfmS5r13uX6O63vFdNhW5oT9mq7XeNLRHeQ35b9Mv0wvGX6gXQ1nsgy
+71mvkgsvpCNeSD8MR8pUdDCLsp8E9DqH3hXZjebzxeutdKh0bWueiDtWvLIVH9NSrQgmHnq2XgJh
+5UGfi0jLJgX7M3ZvQwczzZZFYwZNPNT/yCkPwqm+GdnXZWG/09K3Cf5+bBdKU/iVR1+JzxfWjSCM
+RDzn8uV3iojEh1+BcFQe8iqXtBulImhZNE8/laOR5kojNa4Kli5k7mz6VyPybE5f9safcHyGA1vS
+aekh/2m9Hk5M3YuLPiOIOF3FURLIPmS/lmjJhH6PqXg3ep+ynd/oq4yvaOL41gIq79MLJXMrSVrM
+by16ni9QQX3EnNazynk/X1+HLWC+hpHFZ6cuSGxowIKFT92ass/I0AHt0QG3tJxY5k3K7pVWcVRk
+W4c8/UKm18dv7FjdC/W06cOSHX8t32Wfc2NJb8YY1+ODPBR76olqqIWu/EGdSPL8Kp+PlBs+QSbI
+9IvMK7IvJ1NTDY6xId0iv7VNblrOjUYB5+w3TiI0Hh/O+mYtA0QkgHmVmJcdJxxpteJh4USTKkrZ
+cjrZ1VzNdsPkPGZz8q/8r6Q16xVspxpFxHReYf6RM0N1khckKVOyceXe3jygsqAaKKE0ePJqAFa6
+J782k6cLmejrEER1LcGsLKAmCxaqR5i8JgPl3PPckSDd7AnzZUnPmMk5airQvPBt3m7Ss6WABG1+
+pLnoo5cwTkpO5wSzADj5k+v+TeklE9dhM2GCXYZd/vD0SaRglkE+zDLMMswyzDKUkydmeTMavwuW
+eXnPSVrgj8ugXg831tCpp0FVwtQ5oYIHtP4tZaRmtQMzO5VdMVnwmf1/zpW1agk9COPqnq0pOvoA
+LGdgXcgV10ouSdohn81iQ5OAIWaHmF0ZhE0iCmLBNBDrJmIXT5EysXYN2PavDtQyImlYXo0L4Got
+XJdq7viyQc2RMN1Nb6sMli4kEqWhlVyQNLFMI3KPIOvpMF2RmmsWLXhwwyy3cQivHAc/Z3AJdc8E
+SjpQI+QUq7vYhkppXGAjFOocLMElQVXFru8b9zafgxI8qlAS4Ykq7sfPvAC7K1p4Q/ZEzZBKhOOI
+0zHiFCykEmqOISZxCQpz08n0cz11CaqwaHr6mx1vWFwmyXu7mw0ZUG32hLwKWihzGVsy/ZklfctD
+mTdNruJ2ygctbKjiTNXh9/xtpeSQa8r9eY9nzfygwGY7i76v/GjBDHmy6s4cKZ8XfRKD9uoXfuJc
+0fcOGIa+ebJHfYfzjEW2Nc7/F8xXO4DHORwPNy1x/RvLYaBqoGoaTfrFKude8F1XOS7ng0VSBV7R
+5CnzsM5/Xh2EVzdqyf1swGLNA5MTe039qqtoLXicnr8UsVk00WCfpsT8GLt0Rfba0rKyKTYeqZu8
+CFrjDGpuOLAxNE5A45E0njx6cZXEqyGdsBRZ3/BzQemtSVUuasCEuGfBgz/zd15WClCWDWWhk1Ni
+Kn4yMyELgwGDUaXB8EwQJlZp+snMQBDTJ+lnhx/s/ACtAiaSL+3S7CcH69aPokNWrcAyd2wBvxvS
+HV7BXrHUo3NasBXPTgI+tsZQBzQSbH2rQrpiS0oXl64dFbCmfy3d3/yx5r13uUmffwtduv7RsjSo
+itHe2FTADbirhjvd7+f9cgr4tZnSU5KNyyLS41jKz7cfR5K90wMtAncx19U53ym2tQH+lYchya7V
+O5WjtDcAleyVNA1vqO/61HdlmJfhoQB0gF4U9PL8lDLI9q3OpV4PJFmVOylyZRt4l4T3ZG0sLYE3
+8K4Y76MTkgZKk0lt+1upMA0s8ri7/ycF1jSwumaaBKAvpNWcTLm3q0OasVjY5LmSi30Xis+vyHdf
+7nyW3qMuIAdpLP+Ay8eXuyZvUwOutIqjIsz2tWbrJhKrmTSCWaUvlU5OLaSrSQuI8p4FEA/9pIKf
+4nWJXLEjhVsMhcrpZX4ihsjga0MGX9qt9U1MHjn3de2DirANPpQ+DFQsrV6Pac6VfHEb+mYtA/gO
+TVfZp5ZEnFZl+BsnERpfamk/+scb16RC0OGdeO4mtxR0aHScBluI+UirZWSH6lEKxcIkBSEN2E3V
+YPKjGs6zMlSB+bEmqkznulXYJ2O2tE1ZNzg8tW9/r5fJ/l5qtcQOt3OHv0eJ2RpMfkzVi+3yZJ8r
+dEiauM+F6tH6sVXPXjj95EKMY9niorTtBXVnitJ6dae0DGlFQkXJINcbJucxm2M2f2l542fNmk7Q
+mQxdZZHLBejdOi3+JzOqWAfU7mRG9KR3c9RI7/ekJWkbeYas9/rKv3awV2RPz0dERt0hHdY3BFYU
+TtZzsnsU+yu/3Wmzg56J0LDVifzr3WmVaQFtEnjkz56K4ZiWapXWHqzR1616g2PSIxYW5OX5QXKI
+eLJQUZg6skNF/chpgwg9BT0FPVXfisC7hOrerbr/TvAtQV+bDu8brqdvwmg4wYPy2ipgHdy89A50
+fbKfBgihat3iXUlnjOfnUe7DToR73y3Wp7f5wTRn0oLsOtUroqPHwXeGe8qyFgYhUpjfStXfIDfe
+BS2I2Gt1C4LY68fnS3oc4OIMrutu3X3xxJaRwL0ZdDd0t38rQrnSCcXdYcWNizMobShtH1dE0uPf
+cdkMnf1ZZ0/ylAfUNtQ21HatanvSMkqguV1pbiQXQ3FDcfu5IgJ3wB+fj2g/zNgHM3ZtLp5oGaXd
+/L5fDfqSibXlQUWZU6sc+1kHkdljbzvGowft2nY1Hcxq1lZOs7TjhOriiYLY0mbtqhEjgwMKZmnk
+fsMV2a/WRmMysbBfubHqpPTEJrZp8WYjbtS8mztQV0HZ7zGZN/j3o0irFYVoXFyPCGw3JOmLSvp1
+W7AdHmzHXWyjGFvheCsKNWV+lYS7uCK/cbFP+E70HQ+uXRnyFFmm19X4jp4hcEvGsDlVtv/LzfMQ
+Ifu4InvmR5+wKhcyXh6yILdvXqhykXDrsBUSgTvp1FE7YOu52fO8dgekPFOFH71D2ES97jAIsIyw
+jB9eyIVlPP1GjS/JWLaMPCKFV1X87cWFwbzmsS5VTG057mB/o+aqw2f52gIqmxFaN2rOJRa/Ul8h
+XfNLxkVVZwaRPPBCa6U7evnm19ZP4iAgCqva/diQlsl/x97v3fsSDD+XHTD9bxdlyNlcKpOXnnPc
+1J6+3qYrTCzT1owpme9JGN/j2fie7GCQq8FQE7KWy7lxPQ5wxmJhfzJup3xJwMovrEIVJ4qugWAl
+RHE5ny40sdCcx+GcLOjyi66ZUMwWhsu5mxCzDPQq8BK2z63cTWCWuZWzQaw1SXsR8pzBM5hqefxU
+y2/KbhwwlqmJ2zuN0j2v12bIZzNKmE3y2i+5oMnaWFq6YffDYacIxfvOR+3judgM1mrBL8/bLsNu
+pUndSY8uxiXpqs3XN2XPtXo0nzemAhNWzkzmIU+/Bufazp1r3ZuYGy4fKMzv3ACenPGUbOCeq+dX
+b3XTbL4s3b2zsOfl4efM7C79+aCw9yv4gqrbmWp2YLUGShf6+OxFOslevXE5mn/mcqlaRiJOo+UH
+OKwffgXqJnOjRlpZCiyFE9+mq39lZtHFyeppFel3Q9rdfVS+0j9Q55WqpPPfNF2aIq97+qv24JpB
+V57omp3BFsMWV2CLnerYWxXG4thDQL0O/E3MftI9Miqz8BJsTXrA5C17oFGsgwUzVQ2ZitJnl5Nq
+tO9C4NPL5F8MZAta/r/U5nDmBKAaU8a7yTo9j2UoXtAEmYeTeXJJwH26BV71HePS/vU/0I3FM1m9
+YksaMWMgrTVK63y7CZBX9MX7IJ/fWPKNkM4apTPSKowDi+nVsKb7pHWkacnjJcSVgGbNhmO00VpA
+EZaj586tO3pJtuUXyRsk8dp9C/L8tvR7zKPr2TPFremK4mIdn/9rwaUM0vzpYO1P84TB2zeCvajZ
+XsBQ1GcomDEEMwEzATMBM+G3mdictqs1FtCOHVF3CEuVI7ST+N6kL8KVHCSejhAs77fh65U5NezN
+ZuDCBwY2R1ZxJoOcQk79lNPpgutwxLRdQ0oRYu/hcnanhBapgil9tEjSSKyfnCEnTHT2yFp362at
+7uOn/lLF0naxTTFOq4VFdZyQ8j0yJETaChBDsurajwmfSyZS1Zn86r54Ezyd0u+I/InKv1AB/emZ
+vA4EJ/lyn5u2IzYV1QdZHjwQ5r9/1GG4u2hzThT0WDl67FYFD89abJtYC0ewzg3ZVd13ySU3C7iE
+vR6q/DywLibX2+n1cCEOTfaiyT7WPkKXoQYS2gzarJna7EPtWbXKLOThVD/fhCYnbiALZAsg+65G
+rVpkk8FZMDYoVuv1YH+hzBwoM5wiUEgFFQYV1lwVllUnAJWGPGTvNNsjMxtGUf8E7XaodoOzBs3m
+s2ZDaSdUWyHVlvSrJn0TsyFnQs0HQlWWn7lK2qiDT/C5+xuuyA5pRSKZbrAN9Jq+WcvAkyQeVwNI
+i9apHT3H8jdOIjQZcynr2Nbtbl7LmUIDiGOUjYu2Lmn+ddGmLlzOlD+p49fPb+Otsr7SKo6K6IYh
+T4WG6TW0w1Y7pOU750zsmMtRl6o/yPZmloQdbXpbsrfXZjN6ZHuOvsepuVLdj74EFSqB04axtEmj
+j0jPlK64pXXL/BXkZrhfEnqK0iFrI80DaklF+cmLoun3mAwaD7zbEFOoZ2EdS+N3xKrSQ1CbHeeN
+23z3KE3eoR1eMxKDPJJ+uMtHyfp3s5H059qiUyPePSQ093qoKILU1yL1FyvKbFf12p887bfxcgU7
+3vjgVUXHmJ7HS5K2pBTPfU7gp/eZxpGoKLJf3Z3nARyg7wr6rpSg+RFCapWueD/WE1mIiK16pT47
+n4HombpA550eOu+UakyhMjqgMio+mkJntFtnNF880AEKHaDKlBBYVZdqw+24lxPUBOwo9IRTPdF8
+S+q++5jXGuHD50IhBNRp+NHHDBcd/i4JhxPpKO1gSJGmgCWa3ofUg53qB8YI10m9HsaVl66DTl6Y
+INaaZLD2p9Rl8PaNOuzQIR6IlO1eD25c2Sq0gSd/eFnQDb0efKxqFAR8rLb6WO+rMqvOmof+6Ib+
+aL6coCmzh/4HWpf2emjK7OkRJUtj4MgCleFcZbTLwCLwB0kpS1KQDNg664pgIPRFafqiyZZ105b+
+WTx+kOYzHrC8BipuJcSkzx+TiZQ0NGX3gjzpj7CvWVbLwZguuA5HTNs1XC1kq3hZ/KwpIB6hmwYc
+z51K9EYFTCRtvtxr0AET4p4FDwUc0aS3zDgXVMeKcysVOUMf2m5Jj+mntedu8O2Ovk3hHFLATeIs
+ndZd65f8D/7woQmMSq/fvv6zgfpo1V7e+5bpB7KRYAElLt/nVrxfJnFEOkgt45s/+PGnMjNvv3xT
+dqCJ2QwX7svzY99/2S871upfv+wQvd1PddoScMhNoKSkwDZxHMJpo4hGIp5zmaMpnQNJy4lVms1p
+sFnzzxtUOpLflB1TJPiOPHGvwDznsiKv+4HWcKdeU1LyzfyJSzJklhVIdnn3Ri/EeGtIK5zW5cf4
+tEQ4+zK85Jogo5BRyKiHMgrhrG383ZezZg4pPXHpMKa0hIl115aWEGOvxTjc/MoPSHN50pz3MY2S
+57zrvqpjLYWH6NyyqOlL/5UZqNIWKILT5jr4weKYlmpFwBE4eoHjBF7mkSzisIjDYnExLu86qebr
+TS+umd4uXT+KiOm8Ie/vNeVIq4h09lDHlwQxesq8J30DRA6hY2Lhnk5PvV6v9+Wn5pay78rzydSc
+Cf7HJqNxj7zIG5W+kNUxneX+4IStaPuDB71Oeiyu62RYhRAaw+bORS+rl9cpwuPga79yafM/NWM9
+qv3SnvMpqfG94GZR4cBEqyIewFN6ef5yJ1eOVuTg9K4fTHN2wjDtNnk/bZqQuq2fuSdIOm63G3S7
+XeCrXqNP5/+9Oaq8SUJrhvCX47txOcf56TNObk9OX3lLD04zJkxJJ6f98pMsyp0U61yxzyZpnyY6
+4uh2iCIaXCZmtPDJ7cwPkq8DJduJslcxgINAUtKStE0j6U7zOZdQi1CLLVCLU6bnBBvfBZgLp9Ke
+M0NJOXUzWb7kwpJuJ9FemXpXqdme8zSJ9YwF1E6goCKPKq39pvSSieuwaUR/l9yO2Rosw3d9x0XC
+RMNQ/sHpccL/oP8GzB2A+aB7MS5tU2n+DTSD5sbTDJ0MiptPMXQxKG4+xQvc4KY/6RjkrzwMSX45
+y1+3w1A/O2FGLO48iouExZ1HSVLRVOY9uBo5ou/teWytkn8eqkdZQmVyzY3Rtx/3PWrrp/2lzfv2
+lzbu23UoqH1f9Xdan0hiDyXNR+zy0UZ2r12snJ+ThB30dJmeW7VqoU79uSAS5yx4eGQ6bOnnXSrd
+zq97gEFsvUpzXpKiYlNuHYpP5cSjdOBJgW/e+SNVf7Bb7TDUbH4hczIM3eoHnrEXjrTEMWV1d/f/
+pMCa6rVE5Y3fNw9sCueu1Biq6soOQF+qIDYUJn/4VoUkJmQMd1WetLOrTZEIdbF2OL2uR6y9iUWX
+l1Z9jFn8wPKFDKsavxYWE6MTLWX1g9iaPJLvIw4Ty7QFEH4D4dybiIXlkaChCuIlSXuduM1JLUWW
+89D2ITqFRCedwLaimgIrrhn4RvZR6Yd0ppeuYb/PtXo0NZ0nD2jkeRfbueJy/vfz0eQmCdxUoylF
+zqPqiD9x9Gaq56S7FdMRdU5IC+nk15Y7/SCgqLqh7FTWXOGGTtHdGG+lO+/S1XAv8ioEl4wLiEAt
+IhCokFpisB2oA2aU9Gg1ar0+q0sZjCm5DoA68GHHS3ILB4LTvgbCOR5kk+4E3C1a8u77vOmWLFqv
+hIFLmztmTyYuVTiFslrJHmd51Bho/RKO+z0mY8eDwUirGRek8+4D3Rq7WfLHxszC49s+3PIlbUqO
+ELNy9kWtGr25FdeN8Z1YZk01oqo3z/WIy+wRVwCz6uvQD9ZjoJaRIFvRnGcTBwEZ0xY0/R5J0ahT
+pAeSkapoB1eeh8hDjlWoeiDJkKdWjel1Z7ICNsGEogePvecU704gDpZo4720d4l6dSVeXjxZzTYb
+8t1k65vDEi4/XNYWSbXcd7/b61qzDPR8cZpfrIk2lN+ery0ZcO4L5w3vfzQTipUsDSOtnta9CVnL
+5dw4nmZjbZT+/QuZWLyw4hYwNSXYN3dGaM6U7ibR9n18A9JaNY32ODvP2VwqY3ngGLRrGajlksv5
+s9ngSt6wOUYg+MVYqOJPKY6NYWykudyO1jAAq2tmslys/qa4TJpfn2tiD2FO6wUABsCOAWy0WBse
+mAutFbQX4HIL18RqYsvnmO5/xcoyIAbEXCI2VXGwIGiuzmHVjyJiekfi3YlUjUmGpDeai8IxzbmS
+wAt4OcJrslCP/SD5zr7ky3S7+sa0teU2CKvBLk41Cx6eu1dBdQEsp2BtD4xDsowL0NVkukqr7tl5
+ZVRzl7RqC+nfrts5LdiKZxZmH5uvU8s9LQSwdvWezNhwfZJm2v4JJPkzFryaMfMlkfRnkNRgkpw7
+BWqghOBJs7mBksZqxvfVS3+uFG2Ybb42NzEbLJh1aqTRvbSAl9iWdMLy/YgS0b9iS9oeECEBkIBu
+ScCERNr0ZbqgJXWT+8KuUhnYe4x0WamUx9WD99Of2qOg3dYXsvSRBaYqHFlkeCHj5SE1hv0omixI
+iP7re6Es3Jd+BdtNYQ8kQSfo9IzOQXJCFX0hvinLZ9vU8mo4jQ3p69CvPi9//Q+w+Z+9LzcqYOK7
+IV03mKDyRCpP7qXBBGnr24JASP0Q0kmwoDAWBDGFmLoUUzfLcWvm6Cv98vwll7ElM1WXXKO7HtT5
+jg+4Ivus0cO3Kt1Ap/sI575eVK8ncK3Z2iWZR0c1f+MkQuPFXPLN+fvP/x/z2uh8sGByXlUQMXh5
+7De/2p3WPwDheWGS1nGeBLEGH16qttWRbEkmYgFNkn6kMqBv8SZ1AM0OaxiS8KJE0p+utsshJY/M
+aS114tYf3OnwlkUd2nNYDViN5lgN885YABuY02Z01U5fAba1HttaU/vgt5GH3cUwldXg+NQyePSj
+xqHKbSs76stgoTTqjrpXdzSJ0wHlvWsZxa7LRs6TwtLEDvXBlV9cVdDWthKwzgFWV8FyT9SePwyk
+nCG1J27wmk+x2ZHsHMmmKKvt30//PPjyhq90PxpOl7JWLeFlwcsqEy34WfCzHDIFTwueVmkKC75W
+l32tsjo8nmsePAyUULrjTDW/uPe5iWKhRiuv296wkWiDvLHhUID14LTdlAb2PxgwmXb8CYEUuiY7
+Y8rYyYKF6hFMda6drXuDR9KSvpvdZvdQazhPaDx0vOX9QYFV+tdGmt7nTntpmtQ1kjQ8U5alz0su
+zf7iONux42y647+W33+25xjU2CQ3EWmPeia2v8UxBcGz0+2ODWoaaReCJSMWcoo6oRdLnBb/XnPm
+/OCQIk0dnRfvnvpLzXML4sE8mK+V+TJvDS+1khYJNEigKZEs5M8gf8YdUkifQfpMWeoK2TPIVC4H
+rxuawc2Cm1UiWPCy4GU5IwpOFpyskpQVfKwu+1juM1/ShpRTzaSJmCYZrG9VyGecNNJg6g/odi1U
+W04OzI0KHtCeBGmCp5OE9EDMJWyTZkx4FmSQC4O0fGdE2XSrQJQ3R5OXLWmYsb3TnKRlyC3pWJ5p
+Jdn27nEdKcPBKlhtRNLetgNwf044ztQR5sGBpy4HdUyzZBh73h+Gj4pj9IFMGf4HXctA0zKnEztU
+amcjRFw2GOykV/klCwihT1RGfwBkg0VjL9THfL5AXhnyysokC4llSCxzhxQyy5BZVpa6QmpZl1PL
+yogyKmVHmiuNkvjuNZ5xf8MyVvYHCYUGC94172jold1Y4XoZV3YNMaYT/gcBVIDqu1KdRBQkM0ev
+NFu11lI3LWaMzjC73qfME/VURQgsI7BcGlcIKyOs7AooBJURVC5HVSGkjHlK7nP43pYrg6rOmUH3
+Z1aElbsbVi5LSd1j6FtVUTs/ohu+jIZ7j+tlLHPbM7++EbGHvykus73A9NxJlnT25mQt55hsrGWJ
+ZmeleHgo9C/SlyEyZS/561i0n9wuTlj17Df79MyIafvlLP9n927Tzu0aiOzmCDtf5VqaTRlG7q/8
++5fD/qU2AnNqtn0mcEL2G9lHpR/uHiVps+CRJ/KfTiwqsvLTOBLUtKW/IjtQUlJgKUxuC0258j+k
+GYuFTT30xB7v0wHPr6mThTJ8tfcXjlMaRSTsAEnzQWkc4gbc3f+TAmsayK5XnkI3ljyv+ZDPrlle
+RMDn9X5rFj1Z930+VkHfqjHLnngj/diqJmLfRH/wiuyYZEg6fwC4x+o9d0h20XU/OiyxN9pQy44m
+WdjZxz7oMPdLPlVxsOByfqpjDyfpoJW/NsmZUYYUerLmrTcYt+yBEDSscsU3JfnVBAul0ksmrsNy
+zv57Lqs/vc2357c59uh/dup6hCQs6y9VLK1H4ZDMSp4CSwINeKo8TpwdFHsHRfEkF0VjeJFga9Iv
+7hSi/zAlu9Bt8mG7ict/3/kb1xads+fdjs+2aCeXXT/StGgvNQ5LOCz1cFhqv6RP4nurWWD7Zi2D
+6lKpTDloF4jVHhazLVPg3xxKn/9rwYNpkGS/Ga7kjIcksvOvK9adg+fXunx+rTrXNDmSLZnlQfGU
+neQG79KrJR2/fydvNXDVl1NHa+DfOInQ+KJ9v0uuJFQvVC9UL1QvVK8L1Xuxyukk/25464QvY5GW
+7qRpByddfvdQSbAX7iYeowrRdBfbGbeDBZPzUjIoWrtwE6uiiMLnrJ9q5E/ZBekRhLBlLKUQXZyY
+wwSMjsWohs3GTndgpy12uhOm4ZecN0y4UXr9dimfV+bjcr6WZTNDO3byyySOSAfpRvxn78voR0Z2
+T/ZHJiX0A03MJtN9vpzt/PdzrR5N+u+/7P7sNyLzr192iMjnRztYkoHSkvRPCucFVuZl/U54515t
+PV8vlV5esqQJBnpIdKKHRNEGTm/AaFof4xmYrnPyaMeod218Xl/hANtznE12ZnPrk/XJgkVoWt70
+puVFxTMB6KCmggcK52ixNjwwu4rq3r1DvlR+kODy5cp9u7opsSU6i/nXr666rl6FYg6Xgs0HLLKx
+ruxOKK00QdRhd3zJuS8i2HximQz3BAcOckGy3L6aD/0jwWxycnD5qbV6WwMlrVbCrWEY8jRcE8Iu
++NUUNydX19FBvRSc7oIgjhgG2ndj7nfhBJyv8ZLJ7DqT6nO/ua3GvVk8fzhKaJtSw+naD5kQswV8
+kIb6D9s/KkhD5UPlv8X+gVm6V0yHbxBpnkPz/PJczr/m63KADt+mYXBPLBEigl0bs10SSjx4WP9c
+EAkDohBLcIDUdKGVtQJD5rqgoI47x/ejSKyfxwdfL6M4u0+944M93zzsp9Ii9KesMHf8UfY25f8L
+WjQVvT67+D3mSVkHYkvHwHdy/xCz68iJm8XKxeBWrWhimaXTC8MO2H1Jj+lD/aihfVmD+sRBibBV
+C9IkEfguCbagI+oOOw2r76UYtKXANYYyRS3ccXevL7qhjGywMhLfesUCbkk+r+PZ+30h1GPydzde
++92mNwiCb12L5V4qHdAs6VjjONMw1iwvpgeiWnrfdE4LtuJKu8XpQiJvtZP6qQTT941iq5kAS2Dp
+ZJZQadWaSivnp5GIPcqkAd8ON8hxSqiDty3YLqPy4sh+FBHTOXOJjvVPKVDwUI+R3ELVx5NA82hH
+4VQGsS0yMu6rea/NZKmUXSAT1YdMVD86YngGfVla+pbZlKnUkiPTsOGa+iAHjkum1xOrk36pDVPY
+t+zp4smStC0lFsUDx3M9pjlX8lcu7Z//2kyd/DPRyTjz+nfmTffk1wZDNaaZoCBvCC3QqsmFzJsB
+2QS0pppJEzFNMliDLbDlkq2fbEWTnDGS4ApcHc1VRLj17AhYRxZFxVY9JsH7AQlRTcbcU0vmtZ6c
+jLrGQmwe/gcm+GKCb5WKzlSj6XQarfGn4nN/9KiLZZ8tgjxhe0AyObGqvGpjWPReDxYdFr1+ZXdI
+hDa3YL/6KveDlFI6VAdKCUoJSglKyQelJIjpEobQdqrPSeHFVnJF2k5VfiZae5d9JOI5ly//S5tO
+HCpaj/OOuThSe6DQqx5+XwOGsbSnRnZqUy7ZVXXeLvglF+KcVXVlEKQxjfa0TjvZldUs5LHxyJ/N
+udSqYj2W20xfT9oXPb8NYqy16iehgoeKFNQs+Wv+KKjBZfo+9fXZyU5o6aK6hnqCevqkngZrwWVI
+GhqqDpFcEJ8vLDwoeJRQ2VDZBVU2Il11nnnJKBHb0hYFWgpaqhVaKm3GAa+y18O5F+deqCef1NMV
+WRQaVC6GyABCBtBhiuhKqzgqooSmcSQICdhv9Nu7pjPVKDqY2ZraIvhsZzeNNmBsez0Y214PxhbG
+tm3G9noZKW2/phc5tyyqaN7H8+M2LcY8YtzkdDurQt6DxFJiYdofyW9TGGSs7oV62nQxPD0/exAb
+q5Y3McsZXle9nhwxY6ktV1cNHDoWpMVv3oWFT8tZP3lVooTKi2Vk13l523XYi+zGCR1TjvWcXZOO
+Qz/UE3W8SwMu2nvuLtpxhuv5658kvXZZQLf5UUxIPaS+6jUxKtZ7wWxaeP3kVbFMz1/vOXDpAL8t
+/bkJ7vZrEEZcN7TsuqFlSVeJSqjfZN3nlALWsCCbysTaVkNpTtKy8ny9Y9bk7s1LwYi377Zu6x6g
+9eZfYRpgGmAaYBpgGl5NA7Ima1eEq2TugUelPDmJl12U9zqkEjmkvR6COgjq1L8QM6UD8sNSpUrh
+Mn2f2pYj5JoCf5zUdEmGL+8Em9U+HzXtXD5V1RnDSBluvYphnFpOjL7VTjEcaZqRTjPoQCSI9IbI
+iRI8BJEgsjYiNbeEDFLkknmVS/Ycbiwp031fAuqn9+lrzdY1XjQEQRyx7HnazVoOnNzqSTG+WJG0
+++zBlLRmXI60mmsy5nsUJm9WjYOyfeY4ubzqZjb93mq7X/L//oe/mwCg9PrtCjxXeH3UrB8B+PjP
+kzgiHaT1ZP/Z+3LODI2Y/khTNvJfvik70MQsu/+Y0f+PX3aA/a9fdoC8+08XmB1+Tgu24tl3ZcdN
+Dh8oIbjhSl7ykEROLWRJw8M/DMrxaIz4jAlz2Bzx/brym7JJ7QPPC3S5G1K+J6iZw0DRWeXO5VZz
+JueCbsksdolmowW4rOH/X5n5m+LS3s1mhmzlw/9tPwjIGH4vyEcxTn7QsRR/5WFIsnTxdTX7+wTf
+qyJ8Jw9cSgoTqQe+wLcUfJOwiltwoXRbSK27aKnnajdRttfDysGV1HJOvym7Oe6mPubZCTfe9UA9
+UNJS5njCJkAdQhufSDkQy375MSUjnBAtqYGxovGMD1vUSMym9GRjTbDQDdddB0bIRou14YFJolqZ
+QbJiwbFPobTmBrigcqFyK8BsslTKLric9xO5AWYVYnZQrCjnKtPfYNGzOk7n1rfTpqcXZnUdu5NF
+uZNi/eXMl9u3g5jmsnFEf99cvuXWb8JJbUA43fUlbkLFXUR6V015iU6qgzf/RnNmqeC7v//Mel/8
+u+RKVv3eBTVP8ryeY0/NrgV1XOd0Jz1ls9v1paTExhyQi1K+QCUhCK2EW5Hqa/rK5ZzMkCwFyfZ3
+3EctQ77gpNZA9pCb5CouhI/aYB/VH5y+EgvN92jITSTYGlABKgdQ3bKnSUTQUR0MNZbC092m1srC
+ieuCE7dvQtKr7YqXTGaXivlM9MQSIczomXps5oEgReky1ezgCebWAVHThVbWdj4kCf3klCaoKKgo
+d1Ap/XsMBQWa3NAUa4mARXeAOrKfMLcVjWh/Pte2ZABxB1pZu76m/UELHiTlN8yfi9qyElVvaEXi
+bjYky7hwo4E/pJ8iY9VV8sKtCkm837CG5fkluoLp9Y4UiCNZg7U/wdi8bMtnPeYKp+OM/bkm9pCW
+xJ/SgxHzGg5Y8iuy5yqWIZfzc/XkyapXOhe7jiW/eEoKwMyE/0GeLHkHOrxekU0t6eAyWdkGrvv2
+xdFd/8OGdlqM2rWfbxzFbotp5Yt/yx4IrlelK65WNFXoeZ/zH4xqK5G/MRmyb6ZkTtV1SNLykyaD
+YPCQF6Z0QvZ5M+/2DmfFvjZpX126SAdYkGCW86wa7EeelwXzUQGKU82kEczSeUWTpEISmXHpXg8O
+TOcIvO98uLhFlm3Z9fNnm/ZSrSrySoQKGM614Lo6rqcKZIPsyskuowdkcneyp8/Lj895aLU0p0nm
+gsUm/2V3fk/BOS5nvxTepJKmvBynkfo6TeAwUxUHSdvBO7tInltNTJlpe8ON9UdD3d3/kwJrqp9P
+OKQZi4VN3uFP/9ef/vSnP/9/N//5X/vW5vnN1Yq0YNH1XCpNYTdnvpWu8nMK+709457H4qHSiyII
+9e7IZ3mL0pQhrG+U3CXX1BciHaRpiuo4Sn46MdLlLOOebNpMyUrfCD5tXQruksswbScox6yiQLJm
+a4/GbtcsyjIz/f/Tq/LUOxmSCUiGTFrzcjhoSc2Mi/VM24UUXVG7mTE7ICFMX9MgvifjkfOX7TD5
+uJgbPH8ym9nko3Fr2K5M6xZFAt9ZrZ/cLjYntxwnETbMsZrItErTPVPTet0LSsAowSjBKHXOKP1c
+cEsCNqlym/T4vPAwS9Ck0KSN1aTmWo5pzpX8tSINmj7MIyW6/XgEqNoToPrLn4ou55I97UogqFPd
+Znbj80HbHlIkmGt+/Y/WvyrGWoIfUJN7FB+cTeg96L2y9V56vobawzEbmg+ar6Wa7/rvVaefdf6w
+9vwGluk52daU3LrJri2cUmuf61Mnls9mkoxpTVptxSuplcUyZixjmnB/S8GCSW6Wpi+DRZEM7pfc
+UiUEN1xJ409i5PXfB+/fCsmR+zvVlm6GzdbbvlhGdo3zRtOi0dTCaDSqO9xKNUKpvoVSqX0BBQjt
+qUI7ZuuAVSWfSvM5b1ER+MnyGXJNQbsK4134JVsoU/jMv65fc2X+92bSae/Fp09zQv739lfPepdc
+WNI77szN//7Xv/9d+Ij49vFeJYG9ea1WhBG3nzQmk+x8w1Rn0pZQGtJ2lHT6qar1afajOqosjsi7
+u9I8RNqdf3GJsboX6mkSaB7Z+qITMxRxIkfOk6gE6mUcqkpkeSPLu7VZ3jNkeSPLu6UWDDk/ZWnb
+6nN+PO4zuLdx30+lRThWypbXu6+K7nxvv/mcFmzFlXY7IrMvhHqcLrhOTdJ6wkSmF4lZmUdLxDdl
+xxQJnicUNY3dzrkK8Xau6yDWmqQdsCVpBlqbTWvhsbHb7W4Yq0NurOb3saXwii1pypcEYjukX0MV
+32eefh1RW45rcMmEIJk66UMyVqv1V+LzRdWjtLs9rP0g1nLyUj1HLb0N5nJ+IRPfOgRiviJWvrtY
+DmFXmq1yRgzC9EJjHcTTdtLBhC9jke7VmNmWOnabTJ4ieKU/6di1+8rDkOSXM1+cvz1lDXsAaSDp
+E6uJLWGbYZtLJ+yWyzELeYwIZBkn5E22Dss8jdZ1Qs68dmkItSMWG8qpowO1DaG2qGnfsfNN5nea
+1ntD8ULxNgPcKS0jpZle39CcBetnR1uJFem7FWnNQ5zESjiJvT9nnTUS+aa6yNNNsiewdo91sip3
+Uqy/nDXuRjQ/5evf1U+NczIZvXdAmeI20Qllsuhd8entB0wESeyN/hYvoyHfikolXM5zbxtO5LJJ
+LWueH//PeBmN1CNpLMlLF0EmHiYRYexqDVdLjalwe6fD8tMvoMGgwSCuHolrHi2Q1vKlNVddQlwh
+rl8uniLSfEnSMrGJH16bPbfcBeS2oV2NblTAxHdDur79uCL7LV72H9kD5dWGeb0J2UF1b9ujXJHd
+BtGnC62sFVzOsfRVLf2YmNgu/+Vo0sR1z01x93bpkzjlVN3F1vAwWVYELHuH+R49N55aEi4u8NYV
+dSFOo9dpkSdXEm2Ia5XQW/ZAuE3AbYIXtwmjq0mHDwe19e1775jS6RtwgD5YJVe3aAfkny7w4Kj6
+XSaOy6VWS3iQMFL1qsn/UWo5VRdPlmQzYyYlewYlNJd5MBELKL+5TGYPmoINZs5+2ZVeteKBw94z
+rlrt7Gykk7Uc5ffKueHywXGfnNiq71HY2iI/pEgf6HK5yhd1j+qIBQ9sTtdh17NFS0DVv2TRQ/rC
+DZRMfISmAT2xzLoqTdl58ViE7mI3lj3kRnukvZPOS3LeNNx/kE4GZnyLk1+uXIfbfhCQMfxekI9F
+1+UXtvhWovXX/ygMsGvXfutJ7ED8g2+f0Qr3hJPON2XPtXo0blttuluT55NYxcuy+7H7KK6gO6n7
+xnnX5pJLbhYUouoJlr2Sc9nRORzbhqQjNidP4m+Fm/P3tWbrpkU8++Eq0atT9Y2e0lXvm7UMuhf6
+PK1P92+cRGhq69Gd7Jupwa8ow0o6WI7Egk6s0lRgXXb+SBsX5VJzkuHOz+3oilzLFUmr9BqL8iaR
+e6ksmUIKJW/12rg0E8tkyDRk6J3l0fYuIp36631jyBY0Q+Wfo25Vnv9z5N1VEJDYfi1ur0rv2XdI
+RD53LKerEGU/iojpvOr3I8cwKKE0gPIKqHRPJvR7TKX0BXl3zmHa8iBnXsyRAxM0mwOqzrVpvlhy
+Y5ybvue/Otwzvh1kVd9G8ZvSSyauw0ZyVUsvZOipWsLC5eN0w2dkMRnIN2dqcwU/ZnJOzfTQb5IO
+CPuQBVytdapK5epazsTmmAGwugZWSWe/GxU8UDhVyZ8HVfCtXDA1Rs4+4gnOYFI2r2cjgGq5o14a
+VbicwfHPuZs+4X/A9PnIVDUXNOUoK9g/2D/nSGliYV/OBdSVj/kJf2mm+ZvSk401kPItQ6GCItTS
+kNJMmohpkgFmSXfXsyop/PmDhAq4XV/LBWmeO68EkCFydRRbG1+r41jVOh5vSJEmD2vqGnwf+T93
+s9nnLG4oy3YqyyNncwhiuqMlgtVPVljykgcVDWnGYmGTh/35r/u6IT6/VrT12wYqlj5NBsnsXJ8t
+Lvn/0i5Yy6j/STBIKLWkvSn+cd9EwWXrJDQC87p/QtG06xGziy0W/jRQGKVt95XSIZfMkuliF4Wj
+Wdx7oKhjR3+ydXRq7340xCjs3C4oeLgLAhGbtKT1tG4YveLtqI3NTtV7RRieVB3TjKpqDFI222oZ
+xZbaAfUxHdZzi4QLcH3yjJ5Z2oirPQuCFkDVSvpF0nRjn5ifizT5uxoJv9887NlJuQ6fumjDajl6
+20VLej716jrUXywjux7EVs1muMfBPY7fUfdEMGmH1JcXDpZcFI0Hs3natfHlBUqxAftOnp/easjT
+pWV67a+HM0jVdQEaP4dPi6mN2k/Um1PHmD0m8OLwgcOHLwuyZE9DbvKyg05clTYOt69aX516Ijtr
+Urh3qysnS6XsAuoS6hLqEuoS6jJrzS65DKEloSWbFNFumkKor6m9Xcy4DLmcY0TMCekD12a64EnX
+am3XaYfmvhDqsYuj14sNnvv3WaVbMop1sGCGsCs+7cqUBEVKQ1Zq35UR6W0VT0Vj4VmiIzNHvr6a
+lepOOHnDF3eb3/3/0sSEMD+YnIDJ05k8+dgR7d2CE1fl4LumHLh9ENIKE2kKyqnzA8MrEjUfGPw9
+DwyUEDxZohTvpIEA49LkNYl0q8gkWxK02LsqqnKWY1+U4dOr5EcbGqfBTvN+67oofyucE7Lp/xBm
+aJuSpPPPEM936/EXrMfL84N9PNaxKNmS3jG3ywelZfqaoLWgtZoUvmi/X5Fmjr4XVLj7TSfx9Kq2
+Wua7v6PwOgSH4LB+Dr9l89FuEttT5JsnUw0iEUX+FS37LXvyc+XbrnyvHyZKrCpSt20KsJ58MLRM
+z8mj3MXBZbLVNa5H0oJYMEsTy2czSca0Jq355LXRyrZyYTp4z1qyPr9RARNQ6lDqUOpQ6lDqpyr1
+VJ1+N6Rf/sfKNfqYlmqFeHHL4nRNvFwcU0JEHSTOtFriTvHVqiqIJcTybQo007YOuYTvD3OJ9usv
+Kc+LteFBF9Kd3+0j6ZnSS/fDT9Jqr4kgijD/pGsj84fcRIKtHQOlqS+DhdJmslCPmEoNqlxRZQwt
+7wUngAWwnIL1yB4oce7NVz5fCD5fWMyoBl6u8DpX4Tr5NagtcOWSq7SSMbDXRjAZAi7AVQJc6WQl
+sAW2XLL1tw/juoAX8HKI1y0FCya5WQIsgOUULBWSSPUWyAJZLsm6e5SEeCmgcgrV5roa2gpgOQZr
+TPO04RSoAlXuqJqS1ozL5xbDXElwBs7cc/ZdMsHnktKmt4ALcLmE66fSIoTTBbLckjXkJklIG0yu
+Vn8BUkDKAVLXZkwB8RX15wRVBa7ccTXVBKK6SVRpWcrbpO8LueJaySVJy8R0oZW1goBZ6ZhdyHhZ
+hLDN/my2Z7tlL7vUPFWWKLEhBWoZKcOTvbsitSSr1yAOis0RY8/i0Q//GRs75UuoM8/gClV830j1
+9d3gwNh4bVVa9Zy1XM5N9eVz2+dWXT+XtHVxHO0LAhVL25+3VGPPmDDFJCz9yYNeaP8gzmRV7qRY
+5wpU9kDKDImpTMazexS6shjueY4iYjoR9yEPUwacYL2zYVcRzIt1+uo89qfMrm5vZMu9dMRW/S1e
+RhcyMW2oDuvcCXDAlqQdQ7X5m7fs6X+UWg75TmcLaNWMVk6btgawxSXYAlvlsKVCAOVP7P3NppQK
+1Dkt2Ior7RqpdPEHC6ZZYEm/nkZwuhamdMha77+/gAWNVTpM+7rKvWxKWlDVWJLarqLKY2p/HOSb
+sudaPZo9cze9DXHkTuZpEtrXCHV4dmbg0v71PxpIlnV3XkBM+RBd6t9VSuEjzTM1DaM9+YsDtYwE
+PWWTiQMM7kn2avpmk3+TfKwb/D+PiCgiCwUGS/Rq8yzKkAswXw/zCaZQ9FD0Xbq9HNJq82fvgkDE
+yUwE3Ah4dSOQsUGNBE0to9iSxs2Tp5x92J8VJUUgFeCmpNVKlMbbuy8BcR4SVyFr5ai2TUbZrYoN
+3ajgAZQhs8wJV1MVBwvYSz+117vNabqxTD8GltJr1qqjrIQAy6Y67htbIhnIu2SgZt50X6pk7FkS
+GW7rBXdxpkpI32h+9dZf/6OJWZRXbEmJqhyx2NRQpGL7QUDG8HtBPl64dLHFj3PNeRWTsUjjcG2m
+W69Tm3mO/kpM2MXWAUUVTWeraNzr0TQ1geB9lqEqv/IwJPnlLH/dGqxMm3noumVPfBkvJ3wZi02D
+bxby2CBBqELR8BjqRqrxzW+ZBY/S34QyR/pyFhuNc36T/wvXt+uu75TY0jFXFFvNBFhCNOpUlO7M
+SDCbtOZESMq1acYhZtdbP/Pm7jYSzIG5fObezMS6VIGrEzMsp4seBi89UhvHlInYo0wCIOkmASlv
+kJq825iGcdX16F6TrWMjT5N5fxHaqxlx4sKqMd3sBgI6UEKh9VT5NjaxZzmB+Hf7cq558LDZmIbZ
+2CkJipS2hB7dzm0sbpcbGQh8FYlr2U2hQOC5St6QOu7nEaaR3dF+jIe04gHiyb0e4skV8BZDeZXk
+G3rcVqkMtfgev8tYBnmx5JdZKmE4VedCBQ83PLuWYMQ0W5LNmvmV/2YZyOfFGDY7vG8Ldm7FlVZx
+9OVs/y+9rIDWLEO1Zm9L/r9kkTUmG2tZIlorxcND5fpFwRS0OWUTORDE9AGNnQuw2dD92K2ly17/
+5/SyS62Wezu1u1UMkUpazpWiFgoEoz69zg8KrNK/tkQ35F0oeAvjFdlLzUmGE8tsbCrCULB1JvIn
+crgvun7gnbYPFBZNwX23kQ03Uldkk/reSVJhq+T1sInGKfe8VHwn6tyDvyku845kFS//Pt/zjY1N
+v5DpdQMVctoey5MVd5Ua5PN6f5ch6T//2kQVkxNNboyq/8rM6yEkCWxQ2L2tqAf+a5OE9vsrxkXS
+l+9S6YuniDRPmid1dA/Ki4ZVvrt/58HDCbvY2+/QD2nGYmGTh+3z5p9faknGsDmV4/wXgeUgNy3f
+ye+1LUBVjxZKdP65UoKYrOb8+UBr8AcNXA3ZbiOtB1DOjCH74pYj2OKntm0Z7R4FCyrcyaIZ+43d
+1j1qBPa5Ro1VdYimZWh/i5PfBNj+gX2QAg9VnIzPBdpv0J5sNhhoNxvtU++R2oZ20t69GqgfmXgY
+ck15r3Ui3nXkTZw5iPelCYVFg36akirZFU1VbuumOjRFdsCicXqiibG/MS3VilykQbXm6FjDHvye
+9LnepI8kHdyQBdRtVeDHvfCYVuqBQCWo9InKpJ4Cl1WVe54vCCW1I3AfcZlRhZzjMqN1lxnJtuIy
+o5X6G94V9Pd7QcfVTgvF/Kj1yLsdgqA3XtBx0QVBRzJv2wWdkpGbKpa2P68qhXLf8+pAPCnhBt9J
+c/p4zmWNkUCyxQYiuWVyWeSZJ3K5p+L40zsVGf7T6yFaXQejkzgiPWGz5JK1ov4zCNJCZWbheHqx
+LUBsjXZsYYD5exQyS6P0Fjlt+3VSNXPv0KZffvmpWW3YCtB+8sn0PnfxIf2d940Eyk5RdtrKSIlA
+xRYqttqLNq71WqGzUbH1GW1cZLUCbVRsfVgPJM3ikhYH4U7IOQ4eyK5EdmUHBB3HsBaKObIrIegf
+BR2HUgg6sitbLugvg0DMnRRcUmWNg//ypz8V7h3MnrbviHRMxwMTcoZ0lS8Nv3ESofFIEsZMPlzL
+vMVzbPbmyaNalL3hl6bPFNEuoq0EAe3WoF3vTYNfdF+b89c2Rj+5XSDxrvF815nYs6X7rElu/LUB
+/+C/ndodbguwbh3W70aEdHQEcxu3Mq39OC1sjv5RHu9v4l99jZdMKh4OyaS1DDl/w61xWhR+cK+H
+W38oN3dNgidWE1v2tYpl2DdrGVQ1uN5w9GDvfbg6KXxxYvmS7mKLlAFoiXK1xE/G7aXSiUCNiYVr
+DJE9ajcbFXLiCDnhbN5h/h+h81qz5xernMnnz4v1OtE1DKvq7hDsCRG1+5xX3pyaAzf8ZYRvEgvA
+3ndp79NJRZXlwWLP691za6uS7iUZw+aErOc30+ICHvHsfQL7JbK/OctNLLOxGSyYnFOIwUs1CMHs
+zT740eTzLRnVi0K5PaMKicZ1KKoShiRg29Fobc36705OSVCkdEW9Uu32aYlcedLNd/rulWrTgJFg
+AaGj4Ru/LGKPMv1/ulkRVrNmmPBlnIwxVnLMQl6xd6TTR3q08TOhmK1+34uMy/0l548mG6/0+u3n
+P/t6H1f35VNHuzzTL2lf7SB1LHc6jG9Y/NcvO9jbjUUGrm/f95wWbMVVZrLYe7RHWkWks5Nw3n/k
+xTPiF5Ld53g7b7YkJxydhMMzfbeceO9PzS0Vd/p6uWFqzZngf6QftS94LpOo3pf/7Fkd01nuDyZD
+Z778Zy8dgn7Q++wPh39TdkyR4Hnx7ow//iMpF67nluAEVVw+2xMbh1xt2KZwkIz00OsxzbmSAxUS
+IAfkBSuq6qjYe+Z2pAQP1tdypjy5YSxcXDzk6bcyvW7BPA2n29Er7gFS0ee+iuFR3uC+XT14d7OF
+J/9f2t3cvBwndQOn0hPSKx5QQZ/17Jdi6jtR2wNNzCa+4ZezX3YZoh2P3afx/1GOz3ychA8EMT1Q
+yyi2pAdsSZrdqhUtSdpbFZLpaIlG5Zr23T5gB+ragamKgwXEwINNwPLTl1oaZtCcG0uawmdt5K84
+VNXuxw9nfOfmYFu82hbP7UfXdwZ7UveePG9ItnGpKOGw6HNPDC8cehmdsy6INtTlFn2Etnpcl96C
+CkS9QjTDAem2Us1aFODqBa5Qp70vnxcCcNZTDJHpgL2eJUo4OXiW+7vLunfp+zMsRueWwOePL+dK
+cfNYU/VVYvX3hR87wrjNQTqP7+8FJZVlbtKNcmque+/Si3abjJ7rdKI0S6i2fKJkVe6kyE24QmKd
+88S618Lo2KoEBNNOuAvnyiU/iHxQd9i618NpKSAPoIihiFtCdFoSMcqrngXRrSJ6XzVzwSpmf4m+
+ZU8bng2AhoouPMynATxfS0taMuGG66Kdlt9DXqCErtccBxq4fXrlb/ES6tMj9XlKZztQX7iAVdOM
+tKYQ7MN1aC3VcCD2YA8H4nToxpS2GplmNyFCPLcmmnLabvjLk0CEqkyr/JWHIeWNxmisz9r6SJfE
+UQ2C0UgP5MhyxpxrN7TV7Uwa40jEcy7rK7pJM4+owMVZxaVPruydo0Zq5ZejbVb/fP09b6ALhsfU
+qhcqhLK2Iu8Nh5daLV3MYu2hzz9wPB1HXwpyC4xHfG2ffP9PCqxpXvlN0vC4fx8bQrf7w4XewcgH
+ZpT0yCbXPQNDpUN9mbjFMaZJk67raEiYnKkndi1K1lxv5s5uc2iLTp81OS9XcVXh61qB3/qP4ROy
+b87g1bXSbNkJ8MzFerRrrsDJSxJyk7hoWJXe+6BqWlW34NF0HZW0MIeq9Nv371Tb2nDzXYak//yr
+R7xklzMUWJEONuUt2d5NiS1xAwIgPXC9fi64iUiDxlrnW60R98W5fucHzHEngTuJzPj40Rkx1U3S
+Pxj07t52tGg3I+gs6KyOUA6F1YKt3GSCfY2XTCoeJl29RKK9hmTSWEHOX3Crv8K9D2ycBjv9UpjP
+/QkzPiMy3r4U9PpBIw8LKIffOInQeK4YkKgI8ltO/lWaUrDt6xZFxHSyVn2zlgG47zj3p7l/TeA8
+yUIA696yXunM2ZbRfqk5ydCAbmjy1rH97Ki/Obgn7vpdbGfcVuWwq/ynAXgAXzbwOJ8C9vbBnvzX
+V7rhwLQjSSMvAaeDkG/gTjBP/seKIW9XorNfnOfIbEcxny7i5b1kXECV13a7Zp/3wJ87tum7V6p/
+ZSb8D99WJn2l5kfnpnEkWu7eFpoptgnJjen3mIzN/Q2UnyMVu6rlmBWlsmItuENaKlGFng0ivEqO
+B1Kq+PPAvV4PxRtt3/5NunQ/DCmEsahw62uYvbvZ6qRIy1a12cGCWX9OBK8LUO+RAA5CD8V8ec+3
+TL9UsnReU5ZYP3eI2lRSUmATLGAmu7b5Q24C7H939/+1K8hgweQcrnI3XOUx/VNxCZHvosiPaalW
+2HovJP2XnL+T7LDS67cf/Pz+Hz/6ww5/+udJHJEO0tV6uwpnvxS7EUhGO6R1Pux+Z4PCZObFin9c
+1n/8sgPqf/2yA+LdT61n7LkSghuu5IVMPjbEuBFMxyvcLMzf+TlXmoc5t7TAubs4N3JS2ldlbPJX
+03rTnDDsgWAfUK74HvRi1x0Av2Tw94TNXxvq2jjk6j1ATZeAazOItc45BUAUYANa5NLcxsLySNBQ
+BfGSpE0n/s5YQBnHG8hBJ+WgcDHJfp5KFZBzWrAVV9qtkHw3ZPrGkL2WhnTC0VCzeTclo/Aw4zIE
+o3PK/7i8935g+YrZitK16CkQseErulXZA41eNxn9yDs6AHPzFv2gujZnLH3WdYjMkZfnW3qyWI43
+z2c2NlMeYU3ejlwqOmuJB0piaMnH1UtcpKIryIRQj+dchtlXib0eTGdtpe0+mc5bknE1hpPDZB6l
+Dy23AsrwaFOCknCowCwVOFVK3LOK+rtL9CcAjDu/YEjs9OAKwgjHtnO4trSsRgU80BqG/E3D+NSg
+p0HUcpZlXzH/p1f6wTRnVdXkltqeIPdLmjNv8Irs3xSXyVW6J7qxaCJD8tqb1EyuNu/fZB2ZF4av
+egc65BlckZ2QoMBSOOb390omHmvDBOHNizd7H2x1hVitchRaZBR94DBNkDu5SWpTW8l92IEWzXG6
+liv1QNAvB+uXkw8iTM/TvCLjySkkp7WYD+q2c0PPr81z8ksjNe59o12vN4v/k9vFRZHEIGxHadvx
+jeaVJYGp7ajHUrRygZmTh82e9EE1VzpIs24U7yRcpiNBPNllCpgQ9yx48Ec2X6jBjZYvftOdnOB2
+BRIKCfVXQiOSm3eoRkRN3rNORLJBTQjfpDL9uWguk+CSvsXJJvs1AABxCh9c4YjkT/7AR9ktLV3P
+otACNxRA8fPbj1hsaKJiWVG/OpM8KumQJ0l0spcVQrhFqBRsDSgBpVdQjsnES+hKYOkXlkmt/XPW
+0VRt3gmJyZXvQroDTrK+DtAQNvtJJ2qGQ+cm7EsbK6AaTp+ZoAzP2asaYmjfh3z5F+hKL+QzYhpX
+obgK9QNG3LPUZCNWKF+BL50nmEgch2xCNv0yl5ZpO6SAibyee24lM0weh6ALDEUektXRqNk8p/Fr
+1cpxyNNFYnoNJL3QjyrqC5FGpk33Qn9+aITvsrKehoguoAY58ws2rXKGKnj4ycN0QmjyPlcxr2pK
+1fZxaLn51n5/3o5rOVP+SPAw4wUh0e5qWH/jJELjS/3qRk/8F5SEN0riZVXuomQDDZz9xmSCt0Qn
+XC8jpe3l/VNf8iWrrkm35vN0+g4yzY/rIMzN+M//C62D4TpUrCbGvOT4F+QB8uC/PIy0Wkb2UumL
+J26Sy8PNoKCKskRZ8rAC5z0UeDS7BZKPzL/mnpbuKx7RT9zE8zkZS+ElF/QN/Zyb0SPFcwG5WOVM
+Bv3UIvo0sfhHjfHmQt/5XQrFwtPSZZx8pPvx/8kDS53+3/wR//382VqYhd7dObh55s3fSbj9/ZOY
+ADWGOzcJ6cGCggcKMai2ctgBslOQt+e/yUJpG8QWQAPoprsbFzI5CEA3A+WG6+bJnhG6cJpxEmwW
+0dPsGdmAGTC7hvmI0OtU8/mcdCk97j0IvfZ3XSm5CMBmoXVKBNbZVw/pPp5PSK94QCV8+2vw+eyX
+Xdpkx2PLXLbatHveX4R2h3Z3rN3LhfmWLykn/QNAA+hmAT3Kb+MEoFsF9CGNf35QYJX+S+PCIyRD
+0sAZ+vk0/VzSmUOz+a4TZ+kHDt8PY1cxx1HstPLCnWlULiIUHclzfzP2NE/Hxsaq5U3Mkjg8+ZIh
+fPGUVImMBAvIu8zgGRc0YnaBhGD0U8ki19+8dtDbaHrL8VZumWRz0qW6KnA34G7A3YDChrsBdwP0
+NoTeUt2Na2lJz1j1IZJ6r6T7UURM7/iiEyPF10Fbrz2Q7nm4JnN1o1EWrVNuBQFX4Ho6rsd5Tv0w
+7AfVtaliQXnThdAUvMkufD8Mb0nG1WC4zH4SIOw2hN/osXqFiCaeb55vswsHurQcR4QZeKBke1YO
+Lc2dKrbt+D6lMdm06tUfCGJY9uqHeizUY9+sZYDrrIoagpUUKP3srbcwOHrKre15bG1VHut9+ix4
+rB9mSFseYUXeeaKyVe0iq/Tj23UC6pQfX44FTIbC3zPdqmaKZV2pDAQPHpIv+rkg+YPTY5Ia8JWH
+IeFeEG1gMlSSX7eCTrsaAVfgipQLsFqgZrNoEelASUvSuqf16JHz/SBHzEq4slkRZqN0J+BYqJtQ
+6ne2s5PQ9vixM8rUlfKIK7L9R6bD5DVHikvbyDHRPFNrF6AvxzofVhnhSb1Dup8n7+UBliM2pL0K
+nObM5SlgOk6OErKliqX1az38NaT7xo6+JpXGkaC6xHx7Y3TWJEVwRfaKLTdq/ZyJvBMSFEKTho5B
+CA4SAggABKB9AlDo9LZxA1OPkEKwfwT7pw9vL7AFNS1LfYuSQLLVyNfyyq8b9doXZqosE/n2qmnr
+cnRoaHdE3H1AKBVS/5p6ZZkT32JIIyV4sL6WM3Wp9EiwNelTUxQPUbHpA1H54vrEPeTp/jO9rtnh
+qi1RM8Uavf5Kn0V3qbN9gK5ctiY/eNDrlH0dutmUpo3OYobL+ZDr/J4pYMoZUxcyXhbhaaQMfdyd
+RsI1sWsBZeUjWJudadqYBmYefhKfLyyyjUrONjolcFdT/txMKGabhjRwrkhF+oDS8aW78X2iuiuK
+EWQ/6VWdIEJQeXJbRoCv/FvBLX2+5DsdcjK7u/8nBdY0bdHHtFQrgtRD6h2F9fuSL9kO8t6dDAqG
+7coPm22aIqOipv2O1kE1L+5j1sZezGYU2FbVVZYgPUmLWmlyGklAflp75i0Bpwn/A1FBkOSApOlC
+k1koAcPeaJxcW/ZzodSyiGl/4wI00jBDkwL9j+jHugvkn+skcC7JGPAPT+J0npKSds0MrmJAkwu7
+zGysGdJqwJMbnqZc2oESSgMnvzL/kj35tTZnL338QOltklgX/L5LphGNhG51SZQKYjPkO2P8QApI
+HXVfkkI1ZiGPcT4FUg6Q+kYwfAj57Xz6kCK7uJtdJhVgXXABIQbQrA5xmkQ6IQEsQaW+MhHLMVsb
+L7Tp0Xm7A8FJ2uuwiX0Kc4ek7hfVL2N1L9TTJNA8svUNIEqSp8mYvAF/2IUKsqkFMUPj8/9OOwFN
+rCa2rCar2jI9J4zy8DKz2hc4k/EUg6QPJulqoAw2D/uG8TwfxvP8+csZ+pZCph3J9LWcifgpcdup
+ovbCJn0W5Lr3uatcOQuyrz/QwX2CqlgQu9DKWsHlfEQ6IGnZnKDKoMpyVFkSdalIhwXPqw0N9sJR
+EmrxaD1yQjOQ0dpkdKqGnM3P1+lRQq8HKqyocnhGSbIbwe149/wlMYNV+fR8CpQMjUcrEqr4XqDU
+3B9tNiEZpnG6Ic1IawoRqatFVCnZg3TQ2xOW5cOyQKu/ez4LQ54ea0Vfz1t01IZyL025Xy+XFHJm
+Sayh36Hfod+h36Hf26Df7fM1O9Q61DrUOtQ61Hqr1Dqyp6Dcodyh3KHc26Lcp5oFDxW67Ljh/yys
+eRvVxQUR7J4E1uNtcuqfvpw1M12kTROV26Xvf3K7yPEOoPeh96H36wWkVb47zCDMoDdm8HsUMktf
+iWl7T8xuJsVUYwkZTuTNRtN1ufn4/qkvmVhbHtQ9Wt3ZtAoXy0Izselsd0uWhZ+H75UwoqaU1x4w
+Ie5Z8GCa+v7JG1JT3z6ZjtrYd0/OaU19+WdTbLyZLPX6jq67katHk6Fae2gj05ABU2c+IZXq3EF+
+zARY1YRVbmMU78FK2gLdSQGqoKxcMaWksZpxifkJ0FTOqHozaR5UQVM5YeoiSYuR8yE3GAgLsByC
+dW3OWfBAEkgBKUdIbeLQ+fmewAqO1eFgkV6RxhEQysodU9+vb9kTX8ZLIOUXUnkdThoAFZeAClC5
+hepbvJzyz9exoApUHU2ViZdLhpuahrvp5V+KX1talprc4yyDp2aBuniKhNKkr5dsTtcypCfIll+y
+ldmq1/sg8BatOx0SRuqCKjdUXUtD2iILCFEVd0yN9LYL5ohpwu06vMtegUzdfPcywyX1MWu3NR+y
+3+lvyMdsHtaKT9mqYN7YvPbfkoGvfuRYH1eCdck19YXYJCSaymqv4iX5MxFmGkdoP77Odjb+fVY+
+gxsAq+EvEmydeeY8Eb5dxj/3ZT5rhQP5O4M4Qhxdi+MmOwHmAPy54y+3v9XzC9/JjSWosBlWh/mr
+fbM3euZiBdt/1GbD9pfKvvvz41JZ2kV7E46K13KlHuCoQ1gb4ijt+54i31HYS8r4ucx8gef/fEkj
+SGb30/9Rue+/kXF4/4C6AVA/t3QpeqbYwA2su451DQBuNGqVAMJ3gjw2Vh7LOXhlOEH1tUIa0n08
+d5us0o+tuhzf3tAqs4krMlXamlU3Ij1TerkjcflEqi7YnPR5LB4uniiI8w4SYKut6XXlwRVy+18x
+E9yuobaqQWtHYtfOrXm3LaXSVYIpvJBJHvDl+LbjQM2YMIcRtf+A/5WHIclchJQdUyT4pnfQQQ5r
+83XlFUnSTDivmFDa3pKe0/n6ltkUGijLrtnhUti6TEIPY2bplsnE1wNW3tjgdGv069bcqpAaSNiV
+ZtGCByZ9fdDlC13vtqVUqgYsWDg+OdySWaR/dsL/AFRdi3aUcGRIgBoxbYdkGRc4jfqlq3btTjND
+Hgh3INzhWneNSSgW9o0hi6Y6nTsVlgKUDEkPJldTzZmcCzK5DwFbYKt498uFejxXsQy5nJ+rJ4LG
+6gRVxyUlXpG9ZU9FfKYCKTS1leJkn4d8ypNIVP6EbNKf3dQ1/+5s56++zneqvrtWsipczqdkrOOh
+HWl0D8qvdOU3ZJalv1FAUrebUnKzmvKYUsuIaW6UHPLZbLrQZBZKoCd+93psVYDYLdmFAlv+xDDe
+7fqnfWo+cCMjNXSapzptJhRrrlYbkkmnfSC9saOTPkoj6zKph7qb/eD0CLKgstyBdaeTwjYGlVVK
+lmO1OYyHHFF/UGCV/rWx3I6U4YAW0DYKWtzaI5pSBleThYpFOHngEajqXtPy0rBKxisR2pV35CR7
+3NXqBr9QBVPN53PSAxbZWFMT71dLaXVYzgXrbnFvRA8wM6QZi4VN5JDL+VXMk9r6FYVNRCZHvRdA
+ZqzuhXraDAh++d+r79hkLrnkZkHhs9efqBdsRi2bsZGF9yIyCTSRbOKONLF58YRsmzTUaVtQrlAU
+avFa4WZ49uXVqGXPPnqXAryK+YTPJRN+fL57l+452HbJdWGnznnSnLOkOKdL8rwbuxL7ur40Vmk2
+x6K8SO5IxHMuE21RLy6700+zYupeLJ03VPmQunuc43jOZThV2wRoS1E1XRpldvbv664c1ZGwiJt5
+UHBqtwHd41kcuByR5io12/4sSebFQhXrMcuH98T1KHB39+mNXsQJ81HqOuJekR0oTRvX6gdpk/M7
+Pp9tcyPhjQn5XJHdvAh2ovZI6MmzKRoa/KxHD12bpHNj91Z7c06pk/NxLPPaKAH0EhZcdvJapa4F
+P3kCCdb7sPW2ccgV1rua9R6x2HQwx6JuwzkmQ7bzy350YvGQIk15WcXVb2gsIUVVL/qE7K/hSx7T
+psl2WE20lGRG/Pk9ydUFB7ONT368q9e2SJgfEYDJcyzmKuaXKohNVWDOkoeBS3CZwaVVEQxV1av+
+Xd5zGV5qtcSt3im3erixOZVEQ3a60MpaQS/d3OE4AUg/cva+EtP2nlhFQ71Nthqug8RQxfcejZwv
+Nznz2QxGFGKz273ZlW6z5UtqzzafgfoyqXeeAhlLZIwetFyJuHqRmV3dwvWKlWNPLLOOZz1sFtoM
+ucl1+A+rx75RARPfDemXXytSnP35t9AL5R9osP2lH4avGYUpJIiTHG4nT89+ZjrbsWvcyH6c0ktJ
+/F1RUt+R2CnjSUj5Sqs4KrIHfa3ZugV3TFO+JBVXFS6hQMnQdPQ40dErjkIn/Autla4GwiUZ87mq
+rMu22lgWPEw1C7Aob56f6uZOOjA1R/tSXbCZi0ghdAJ0AnRCKTrh5OUIUxk1AOStgxvrPW1Vm1b4
+27QeJpsHDpS09GQRDH5TH+14jEmskxDPDZctnfmVBnFrC/cmq3InRW4w3OOQcPkT050Dfb2ZCTvP
+KY4D0J0FuoLRtCUQnVZnhcAZOLcA50ne8QswtwrmfcfhgsfgWu6ffzIbLCpKhn6KNBlTWlulVubo
+u2LL565K55rYQ6S49OZq9ZDpOnf3/6TAmgau+5VQ90yYcqV/25M2eeSf9sn9u4Bu3oTuOjRFZXGp
+UnMBblnUQFLT3CiAClB9B3WSANGx/KDaFvt7tEqcdegF6AXfUU2PWenRspqz1mPOse5E9lqY0VoY
+vh9Mc5Z5W+A9gITzVWVrPnl7rq1G6AWXhA7e24eb7a36kKKk/Fbu34061sjvevzWB58mz0EQ1N3U
+IKOrHH/oxPXYZ9EPs+w+yGIXJsihBA6ieLoonuGkD0VWryLbRqagyqDKoMqgyrxTZYVqm14PzP0w
+rKq66b7kUzrK2Ypt+enjgrHpDdn0CxmoWFrSFKb7j2DlgZt95oT/MTFTVmrchYyXh6zG+ZsX6kaH
+RhMvyx8UXsOXpfc+Fdrv7l48+rDRlVptbHWFzRY39QSboi/SpZaK+tQ0sV1z7Ht1VaP0g8Qfylih
+XVgXqErZWTJdpEqlWK11D1UrB2ikLlWtXHkzTKzSNBYP5k9V1XlNkGfT13M3L9+H6KH3msvu6s9s
+DBZMzk/ydH1y7jKIb62Hc04LtuLZXfSO83ISQz+xBabjNbv8tnBn5+QHS23snO/LbDw8ltmzshHl
+5O5FPRmw3LEm8O7WzSrN5h1YsZ5r7+2SyzD7M3ruvbh0O75hIBvybT9UyAFCQFgrhHNACAg/QNii
+4ewGbIPthrFdKPYzEMpQ+27o08+6YbbsTyswhKrk0F2qlyrMRcjXhCeqJmQjFNjsNB/htLwa7Ler
+/S4jJMMDGmm14mHJOQm7Iy/nWj0atzkLvdrmFaYfq/Q1OqCiA+pHNri0f/2Pcluglkd0zmUrmG4V
+03vSjHeC0TSor9iSoKOho1uio6+I6SuSmiZkLaYJeAL22QlBmerV+SeGmicEUsNFgYvyCYnGzdCY
+XF4KNjfJ5rtKf0Ked4ecmPJHbfxN3V/LmXILfvJH4ZMD50OuAj32R0aCBThkAui2HDJTnn+Qzpn9
+Aqgxf7FBnvZI8xWztMlKhqYuodIAnkfdWN89SrANtmtxQtyj/eN6BG1dGtFfeRhSXsFuY4PgHdD6
+L6IBjQ/5gOX4+NI/lX4wEQtwQ4S+KLu5aBjQgs8XuOvHXb9n3YDKhf4RWhzU+6jrj6vyO+cynKpT
+S6J6xYsrZvkv9gpLZU2ZXpYKXZnqKqUe0oxLumTGJmknGHdxOIMn944ON5MefpQ39aKFg+1c5cQU
+EMxiWVDVCeq1tJBTyGn75qtwadsjpZs2dhBUCGpTulq4uydpjLhekb2Q80RiidlYY9xbx129Ejts
+HIAkTmOg0S8aceTounvtD4twrOFB+kNkWgxlSa+YGBFLhq/m/qZbPP+p7nH0e/f8uSZmSU8XTHq0
+KqGKM3uoN05u8z6mCVNQNkI75UuCwEJgIbANEVhz8WRJhhQm1W3Gk/FR+8bJv45v05qtG78FeWXf
+WPmyVv6WjPk8MqOuha/Ux29bZ+8rss+DyCrxN2It/Mn2GShpyefLyk4NA3xF8YYbWxmOphwe95mD
+Q8yCDyhWZd68uYZL+kxbOo+5CG9V2Ehjd1qwv22m7tokTeWS3+oLoR6r6hg/3z60HDWzp/fYDrBZ
+To9UHxQNCD+B8PwmbS3XVZWvd7LacJs7nSPvwZ3PXURyEmgiaRbKmkslPk9OaIQKOG0n/HAck834
+wUNS2Ida92FMkdL2Wl4pNRfUl0ysLQ8qCnIEzyuPW5XhJvM1XZXYWLXPWX15YVZiZVhTV1DmF4i+
+e13B7klg+d4u35+Krt0KBRUwLZnfMHnNh71UekrGIvurWpl+WQ56RI0iahT3y+q1tBBViGpbrWoL
+yhQnb1OpIauQVadLgkrFUiR2z0wBt0Ia5T6sJgOS1XmwYwezulNHJmS/S74ibSrDMd73PBDZaSIX
+sQ3Vo+xe/N+PKqhtJlnfrGWAK1r/VEGlmY0HjJsrkPfxGycRGl9yPr5aG12RbQPoTb1XeP6vBW8X
+FtZGY/o9JmP9yQv7+uGlcNLqguIYKQPNUbXmeKmCy+5H3FFF+v/6f/+/iirRYOPglKdAYYxgjGCM
+qjJG19KQfjm0yfBvisvr2Q3NWbDGGc5T+nGGc0R/0kU/jaWXS/kbK5E8sS9EUSth2IouubCZ+awV
+G4jJ6/sg1+LUOpatLJz5UOlysaLsxm4vFYab8q3nUqbBgsl5KUUvTawVKrSCV5pFCx6Y/4qZ4Ha9
+WcGtx1WNpb0na0lvn9/J/K3yypcKIXBtaXm65Byw4yr1AMrZ6n0zVw6cOFRgq08//ZNJfRmlO5pm
+0VrlWloNZs0a47XGLBnNtK4o64PZBQSk5JSfARPingUPe4vb5KnDoWrpSDGNo1O6jVWunH7JWbFk
+55Rev12HZ1v20QC+joBgliUtLD4e975M4oh0kNrORL5Jr3hAI61W/HPlYvanJlPYBpqYZZ+auv3j
+lx0L/a9fdqD4+U87+PArkqR5MCGb5JKarn1+WvVokrLHYiuwZ7mqXoFeGe2a0cW+AsuFZhEZ9P1g
+mqdCAgIbTqCTPoLlm/4roe6ZqFL9n+3893OtHo3n5uHaJFmL27k7FzJ52xCCClNRR/MKQ7Z7mcMV
+6cRNbjIc4tQlSV+TwqT5vLFsGX23wW1FXVIMD6H0SisIzaoDaVQPzluyLCdNDkCWeEHxQGuIp/tg
+ZHoElLYNDXKVspPrYRe7wPvS42ypVgQdCR3ZQhemHU0IEwGdkDGVzXOCUw0ic4lM2ygAydpMhs28
+f4KAQkC/bCXz4okbizhMt4PPvhCJMAyOGJ6sRomdeQ8e2JQbyen1YDzrUFWVerYR0yTtBPrqbWH2
+gosQSwKXvz1ay/UF9FZHbRNO86+gn/+Yu2yc58dWcS39dln6UURM7/iWDKU/0ioinV3k+1ImTSLp
+FcvEuQo5mckip+vam83PoTEpb9iT+N3r9XpffmpuKTtxIZ9CzZngf7DUrO2RDZnOmfrPntUxneX+
+YFIXu/3Bg14nbfdazznoBCehCrpulZJ9OY8F0xP+BwEsv8CaCcVsc8ma0lOSbHgdgqvSuTqkW0Vu
+6w3fwZo8rO/V0/kDmAJTbpkawrECU46ZurRgCky5ZepmBqbAlFumxtBTYMoxU98jMAWmnDFlmR6o
+WEJReRak4o0lKkbsE7HPcsBC6LMdJtD55ejDuuCNaPlXlsOcnLcjLyuVUBrEe0a8UPrXchWpe5L2
+NKgAS2296XaP0l3Egpxu6h1BacaEOYyl/c3/viW9MiPB8/r/tdd7dE/qmBv6QUIBV+DaAFxxagam
+eZg6Pzst1QPVeno6dvrPSj3QFVvStVxxm/RgXUY27TVc3XDxNWk0p9++gaaAR5ykvQ6NJ9U2fa3Z
+Glnr3tTabGT0VWYhp92msp5OiYmjQTJ8xbDCYXFgsdXzsLyY0wSXCC6RDy5RzaNnNvRvJGE7pgxi
+UOmm11UqqQLOxHPJYk2Vkme/HBKC+EcT7yUvltxaQppHN9M83PN0bfKnoDUbpjSoWVv4M1mVOynW
+ufD4GyJt4g3ptRmx2ABn4NwWnAVbJ11AwDN4bj7PN0pFSIVCKtTpJN2ypyHfeY6Ebuz1cBI6gigu
+201Urakge6frAtXiqI64DRaAFJC6g/ScFmzFlXYMqmDrO7mZrgCvD16fC56S+fc3Kg4lGdNOpnAs
+PgHrUMWfZjk2B+xJRDgf69g108iXd49ra6OSALU1/sJYCXE3m92qEO5n+e7nhYyXRUh6uytNKxRS
+sQzz8r/Akzue9mVd7dqVJvKE1hpd7C7lHqZkNvwNybld4FyMc3ErzsUJ0iNleF6JCZRkC08bzcT1
+hxLxEkcNJOaejhJH6pdPtvysebeG5QRsjixCTrJyT6gDQq3tIYst2BprXc1aj8nkWHystuO5oVZF
+WOtq1jrqsMouL4Oo+m3srjFo0S6a7iq+BuxiodL4IQ+T8oeKRvPn3iu8LmJ3ZjvLeHk3S8KJJrcI
+pY6l4VXNpq+5OcSFDCkE/63f5/yKcmx0e7q95NZaY6Pbs9FJbx1sdPs3ehPOwk63f6eTUFqEnfZk
+pw9ux2VJSyayu3HFMvRmOs/EMksYqoKasiO1W8ksjTRXGnMqGj2N0X07w1iGF7MZBbbUZoZVNyQs
+QXyGFNkFZKdzKU8loHTLnwASQDodpHHOXwRJXZy4M1goHZvCZv3tDzbSKvetZcEDZADa9HSWrhiX
+t+yBUPQInhxZZ66AElBygBIJYga+HmByANOEhzRYMI5KQ49q+/MnqXhM03ShySyUwCUFDqLvegss
+I03GKN2dw+gNrUhADCAGb7OhjVU6WenuiMGQcmoxIAZwVQ9ASa+hVEGTI5ouicJ7hItBkxOafpKF
+boLD9+HpF8FCdcfV+8rniytEkqBRndB0ox4BE2ByA9MtDwETrPMH6/x7nKw16S5FY5C3CpXa6yFv
+FSAhbxUk+WmYLwWTc+qOVb4LLMOsOMjAp3GXkwWf2S55pwFbJ+2KIApwLFzgJA1KXAGTG5j4bBYb
+TGQATr0echlAUw+3z6DJLU2ujxBjWpG+R3AbIgCFeihKMc4NIMkFSZeafo9JBsAJtvltoZSmpRId
+Sg7DlLgOCkGBho275krX17XR/Ui7/vKek7QbXxz4+zOPPd2Q9G83bEjikG/E4ZIFVmkghbmbpyOl
+okiQngRMwEQDqNOBGpOJKLCXXFhKek6jdXFHWxeXgJYSQs1m0FXd0VXHjY87pzmXYwqUDnMmSvs8
+SC5HPPeD/2Ws7oV6mgSaR/blf698F67I3nBjSZL2ZAt2nTh3vvo0jgQ1bYLpFW0SxjbH8Tz16+uy
+D3n6oUyvm85+MkHoRgVM7BpNccg+9A6cOlLOzJF97as+vUt+G6tsy5D/LxhofCCCE6uCh3YguCe6
+8+lV0q/ODvJ0DEE/tOLEiUdwAI9i+7T0Rb3A8ubtG9U2HVbk78KJa7LP3h/ibsFUVCOXL6elIa14
+QNWIZ5g+61qG9NTF+cQ4RRZg80KGvp3k6z/PHD0k/jdOIjS+jPm/+qR3DLa44i0uZZTYhPQOK+J2
+ltjZL7tiruljqx4z5j7UPVCi8xe8MybMYUHu/cLxlYchyVzBVnZMkeABs5ThyP3DXYw9IWfP4eQ9
+E7827dIG93+4/3OE0iRi+kEQdGM1PJWom5w7HRsyTPnZhG5e9lGSbouP5GJFLJMhE0rSSMRzLjeu
+cEN20zJtSV8R001639Hn/setdc8HC6ZZYLODzkfm9wqhHgexsWrZl3yZKnpTsV0qFqNpkJ0q7MKX
+7Zl75k3dqnsuXA/NjK36W7yM4KJ31EUfsCVp15GL9G/esqf/UWr5nLAOtDqXWVwiW1yCLbBVDlsq
+BFD+lGq92ZRygXo+IfQSf6g3IWu5nBvHhD0/JHnGV+LzhQVq3dNdlaM2Uo+EeGmHSSuJrVv2NBEq
+or6cox4HmqxkTfbdEJRZdyMVJeuyn0w8TCJCEAynSSdgDWm1+bN3QSDS5rE4WXp1sszYoEaCppZR
+bEk/H5ZXtCRpwZt3vGXvU7nYKWm1EqY08ICc78hVCFs5Om6q4mABBec3bVmb1Eztln4NYPMatqZj
+tsm8uFWxoRsVPNxFFmMmEN1wRdcVW9JzVK5vDNnr4SVzdWF+QNFSDylrflWdHIQ/l/av/9EW/r8m
+9IJ/8N9R/m9oZvt6CRGACHRZBG5oDhGACHRVBEZMWgMBgAB0VQDGSfIl/CDIQNdlAI4QZKDDMjBZ
+cG0hABCArgrAlAgyABnotgwobRQEAALQPQFYMcs00O86+kXTbhJ2NsyUPz2xAvzHf/5fAyUEN86S
+biAE7ReCt9S0QgzSeWpjJud0rsJ1Tsc/CENnhOGQ3o/f4oTAFKAWyQIyhSAHkINEDtw1KoEkQBKa
+KwnJbyttcViANEAa6CcP7QKCAEHwUBDcjx74SkzYxZCbSLA1Ok+i55FbvJKlf/nT/SgipgEYhqa4
+QCv5v9Bb0Ful6K3vhvTFUlky6CHfIY113LTHgSCmhzRjsbC+zHn0bwR3WfNeBFuTxrChDwty2KSh
+49bFw49/MQeFvr/IilW9Fr36JnNKywJrBiqWLe3fnQ7erG1EZ7Iqd1Ksm5sZ1rQjQvIXxxQQX9Hf
+7yMDqAF19SeUcrCekAzBNJhuC9NfiWl7T8xO+ZJugTWwbgXWz+cWONWAuiVO9a1acTl/+UqcF4F2
+W9AeLdaGBwZHRnDdMj9kSzZOjcC6jVhbinBuBNgtAhvuNbCu3r0+Lv/hiuy5Vo+G9FSz4IH0ddjE
+LAhjNZfz0vMgyt+MzfX5d8PmdHt/qfSUzU/Yj+w3/PRsm/mgV7nct3/HlDB/epEhrSiZ4Ko3S5Es
+QO4v//uXw/6lNkbzjFkBRHcr9gqQnCrLxDsum6ghmrr6IzbnMjFhmx04X0/pycaaqtEKv8ek13tK
+KSrUDdtv/6+XtzpWM5ydujARm9O1DOmpnIUpgnZxt6KqBZnwP6gl61G68rrSKo4KjZXiqapgeu3Y
+vTna+/6Nkwj//+y9224jObI2et9PIdTV3kD/2Jbk4w+sC1k+adoHtSSXuwcYLNCZYYljiswmmbJV
+g3n3DabksqvaVlJykDrFXKzpWW07kuTHL4JxNB+Y1FGSRwMnR8ZIGg2hK4p3yzoq51Tlf0vEnAff
+bZH3uVyq4V7sfXfyDNnCB1TpCQRhgZaF4dakSXdyKbnsN0agnfFhYXgyuTZledLv79NG7EBL2m1d
+vnOat6QFPWKi/B5s0j4UD9LCt7qme4Cuf8645GbQY+YxlrPIPH7oKHzdVjL1V7SeK7A55ELVHXB9
+aeLAUReyiv+1OpCcZVERKpeBSlcxFo8jNxGUv2IoDrqny4gsrnIxsMrumE0GE5WxvILgX3+ZJ4gc
+oVC0p9CHuzcSB6VKUwmlqS9C8L4I83SiKs6kHjbbJRyiLtQIdOXG/R8C13aC6xwkaCaQ4SWe2NhU
+3LZWJqqp0hy4bm2GELZtjahCsJfkQ2bf0hfBattgNaWV05RbhdyLqpFbVWkKZbjsV46LDDtLxEUI
+C4Sw33PX9ozwRfjCxZfLdNrUTHbC1bJxVenkgvp/hgeX7zApdzSTkykOJijCnKz/04HEmd9jfIR9
+/9MV6gW6pRwWDWEviQiV/+eKy9yC+X8JbKsFtuBtDaJhrc2w5lwQupA8rL+fcB3RGpt42ZG9Yccs
+eexrlcuUnPjkxMfEleFJ5eb+35BYU5l2/q9cqZTM/tUx+y+5sdOjKU5m3bks58L+Hy4rL/lBRGlb
+SmlNNgSN3XG7+JuVK5UbqNwNAESlmwG9L7dxjEk4eHUH/MESsAhY6MAiSBGkcCH1T6WGFaumKrGt
+DMebSksYWx9/601us9wiQ8xNaapM/nLlRlaKVH6C1laHI4M8GptqOARp6am4pU/FCAjLtXYIu+QS
+Khe8PxC8PyDAbSvgTriGxCrNARln05mGE0CfcQEuPEH42q6IUdHacUZHkkWhlWkoKqKmHv3uQD2R
+pb99c6HBlc1VTiATajyckSC4KMyKP99m3BVoVpoULdrChIp05Go9U2RkceOSwSqNJMk1s1BxU0Qr
+XSUUAWzr0sPCIEyzfuUqF5ZnwlGYtqbSMJUul/3p/yakUTI1Gtwmya0VlyOWqGEmwJKqJCbDhFZT
+aZgC+ATu836fCiUJYagIc+nTQnAD0mw7eYUYmfFjJ5MZn/H6ul+toRkbAG8tmaicAXM9zKlKk8JX
+6MGFU60V9V+h4FUofJ1xmVa6IGCSUEvlAoS5cI/aM0Ul56sWsSrOZH0BpYesyB+asV6CFjng5ofW
+m0SON/kdBDHqUPZ5aLmK38pLnzJK3F6tornidKaHMzmb9cQXNe4kIz6EYpx2+Ll1WUiVbsYScnyR
+4wvfMfEbjJ+UJjcEdS1ABNUlk/2c9cmMX6EeBdMjaWt4AA0yCdymwBEp0+OZGTsLgotLqPQGPHmU
+YEgnbl8pZrDM/0uVMNEwBqw5UyKNnqjhN0aE9GO8MoDLnJVm7SyItZy9/GFqsLil1n1MdDVsUfqb
+Z5sJsyLxZ2kpQhc8TUF++XX2vt1IMf7yq2+i0ZalEIV/6V65SVnOmXLn3rsUgd/6p2+IfgtX7JkP
+8+FLxwX3VqEnyrbVXoUEVs9pc1c5SG1stw5X4XXkde5+izTilmrERp5yhYsoZ/ROqkSLP1550GpY
+ueMyVU8V12JUJTkpSIproTPZTQaaWcrpJuseE1TF36xQfu025tcGBNQlG6vcUlv31QqZTg5ncjbh
++7oHi2q5BDXNU3gtRDYU19r6DkQR0Ra/fxoBbjv6qbVBD7kxXMlLGIGgbmqrpUDfPZ41jai2Rd7n
+cvK3uexTzJ4UZwiAGWo2SuPp8L1hbQ2ZVgkYQx4xSvXGRFYHZAq60htoZa2ASht0AtJS8je1HMUC
+mMkgsZWuLeJHZqC0TXJrKk8DkJU+G0JlwEzlgQJJVJmOC7xcgCZIrRikjHU9rcOC6lamChtL0vIh
+uD98DAM24mSArZCf4r3TWVM3xYQPe3wIKreXIPuUJkZ2GA6ygOlkUGkqaUG6GLguWttWlhNSIpQt
+3fQK0FFj0iCPHBRb6qAIVzM8Adb/N+nYQvCiZi1h3KzU4JPgthS4EcYoYR8NVLpom+f+coWcFSvn
+rJicT3E8cZwVp8+ZUBrdUTFQT5MH5Pltq8Jl5UVM5WnAxWSw2Ix8IQIezZJYCG5tljyyPhXlErAQ
+gXXCWV8qY3liKseMtCVhCw9bbgJ1dywTp/d5QlkVW4etgPbXpG9NZTqM+q0VRjAjdz4Sxr53ySZM
+bRumvnKTv24oPrau2Yj3iz9euQJDkW7SjXjYmuTjF96JqelF+pFM/CAm/u+9yhPTkst+YYOpmYXm
+hDDKZV0MZncDbsG4SRKELVKUaLDKRG4q97m1SlaUrAwKe59UJRFZECJ75FmlKZSrJa8ca5Y8gjUV
+JtPK77my5NanVmIB0iyKkgPKsaA8Hmw267H7yh1PKUefWrmis1YPnimTmjgLn7Mcru40yzJKz9lC
+cytIE4HeAIZAg0mECY6mpmAfzkyr/Dh+1FgWfEpcEDTdtioEqEXYaTmDbmb84AlkGuKMw/FNv71t
+TbC15tbh3STeQAYiGYihEPY92kAg2yKQ/QiWs1wms3I/Xr7mHGxjxLhwTRULfv0QjG2m2RAs6I81
+1UdaoQM213K+LT3X6sOReT+soKE1m7vH7HfkT7v/fdyrdtEDOB3Bx73qfzC/mwMm+5AG2Pe51vnL
+jL/o1qb0+O35vJipP+PljfP2nRKpL908A50UtvCbP/LzT31oDzkLpqmBWYfXL7/+8h6XfJCsO2vK
+379+eee4//PLO8f7/mf5tK9kluHy/KQT6kSfNJUQ7P54fAIPLBf2ZsKSKMT/E3B8VEAZ1tZIGXhb
+5ls2NTIGnAnFhOL1R/FX0K4R9wU3VukxYZowvW6Y7uqkLVgCLSTcTodEzIPbn35lK3C7ngOrubT7
+u2uI8FvJR6ANgZxAjg3yMC/rd25BtNd17Mdz1zILuFe+kbjDnhhqFACdNwBafr/nD0eFvuErFoHF
+12INwfvyRLN+H9JpZTGqLvtxqtcKjwMj5G/ZC2UCet1VYgRNJY3VjMtNbY1L6N4+dD+9QfWN7KmM
+oE3Q3gBon2uedvk3SkEkOP8MjQehmF03PBc9Vz54dy8I6nUzvr2dSiEwTy9KVDgX3y0EpNMhrDME
+0IuSmHyJk6Pwsd9RzunYkomG4YxULrJQyEJZJ1i7zhET3/eLUrrg/YHg/YEleyX6DaDnJDq4Xz0l
+J2AZF+QFJOaOj+0AEctJ2PdSJUygZSkQqisVsrOXytm3BgpMd6k/GpkYGHBdrBCqIfmwONRWOpnu
+9KmKnI8/72+Cefrl19k/WXoCC5/EPIlNHx/N7H+DWRQ2F7pGiqeL14X5vUZCo/KYGbjtXF4w0xxw
+CQYulLErUqGHddXX5jCaSo5A256ajrK5zcSMXH5clsgLWbdarBBZzLI3iC2WAtBs3FNNwbN7xXQk
+ZE5Q0FNOOIGTwPnRGk6H3BZlJO38XnAzgLTL+5KJT6CUzqPyqcr/In7ZSpT8xBnMwRRFYnrxP4gm
+0NsgnPDi1JlewV4Ic0CyA0blOoHj15KIONicLY2guWRorgxndi3TNs8mV6WVrqP2muknXKez6IEe
+mpuHWwMfP4zoJGKcxK0BvZ6XYVYp3zqoTP5tqDpsnLBP+YXm0JRK8z6XYfSkRx+tv33PV0jshw21
+PPTkr5/dkJRrmHVW27Qn0/5DBTdNQFmAz/yn1ZdKwx2zoP9nEuRzzekEd60xCkPif6a/+qubu2pB
+n4BJQKZMWvO9IPZ//vPf/5Zt8ssW6LfiV+dkftiV1bXt5mloN11SB4w7+bVl0gtmWtKClky0QQ+5
+MfxTD2NytX/iMFpmamC/JCDH0W3MGLAtCsgRMMuAeZv1XAcGIFwugstP21xJrjVI23D7Mm2wRZeW
+Lu0Ha7jJQLbksVZPBvT/ntxc3/Ruu6eRArcUsqUozGxofjd1QNs265NOIWyuBDangdqGicSUZR3Y
+1g+Tn7Zzspl9F7dwQ/rOV0SsRaz14RpeMrThoz56geiLl4j7JDYXcTa+NAHbDHBuRrCsC/ZEJbkr
+KT7hJhNsPCMjBhmiEp4o/YbYswSek+fIpJE5+V0XwuWvn0+q/di3uIzt+NgLRLd0Obf0pV81KRFS
+IisHz4F6KlK9f5x9ccKZUH3K917ioUw8Oj01+SQ6iuUcxa3kk7j2xNQiG4toeyVgmaXMwgSTV0yy
+/szGV0QSgb3vWg0z2xpmStszLgJbd28S9/7jnWP3wEXRHmCSsReGWcqqDOaZB7oKrILVM3L+jLqF
+O0j8yUGkH3gR/7X0e2HoYmzExZgn5XSmY5uuhrsaRU+dIj8qjnmZFbI3xyvwKxEGadJAdDHjm08g
+0zCrVdOKkIohViFWITNktcyQteKVnh63yAu29Egje5NCT97B9fFHrfiT5XQ0w3X4vTHEabtz2mz0
+Tk/+90ZOYxNF3ChggCKeo89rC87Z8KUh0hmXRVukSG278iQBYzYlx+DziaVsCNvKfyt2J27kz50W
+p7XFcfI4TXdyNR5yQbdjKh20VvoKjPm4PGUNX2PrfUmmLnCthtuiLG+kK5f6mRxiPh82MMn709wg
+tzcbbCXvxyR0v4TLkc9qArV+JtSv5GvYpKvxU0MRpzd7Son77VCc76z+Du4jOfFn90okJREbCy91
+vWQ5xSuPW1UUYPnb5oGCuRmBftLcvri+trLSZeUQ4YYQRUYCkcK8EPhlxt91x670+O0WfFQE/dNU
+uS7oEf/b+K0v3TwDXbRtf/u3fv6pD2MObvZUUwOzrpTwy9/34Mv7YislY6v+9cs7F+I/v7xzAd7/
+LPQ2+kooHWvYxlhAP+cpcAvDMLfmVObDeW7MFEHuy87dl022Y5kZEi//6JkmMVQpf+CgV3M7r16+
+biNSJgpw1Nd6REGb2UHEZAWeUvoTXe6lXe6oLTpKb3cY66c3gGF02ye+gfN2ZxpZBky/s6JPTnNt
+iGzArlSKNMm1SDh1wwXmmiT/999aoRmv7gfn+pwFprCW0NI7pxV0aHAosBXGxBXLCGtLxNp81p+0
+zhWxlmi7AsuEBGMIcYS4KIi7VnrIBMGN4BYFbh2V9wdEcIS4aIjrwbPNdZGrggO4qRN9HrT99Cvr
+DLXy5P8LnqYgv8zlNVgxAKO7AHL9wBL4EOIfOQLCv9FPOOtLZSxPDO616yYDSHMB+iS34+Y4EUiv
+9WslwevOFT8Y/qZNBo0t6aq5TbmRYiYJzfABBbiVc7nyUpU7x1VQtRIe4B1mCduE7SVgu6nkA+/n
+ms2KKi1oNQ00sLStlJgI2UyAr6VD9W9Hs578+bqMLv9GBEoE+hM++BLt9R4zj9/1+7ISlmKH69yD
+CjlQl1vVMIb35QdrJRWCq0Kwpu5h6Y4giCoics0B0ywpkmC2G1aFikHWHD9qhc90fCFo+weaB1yk
+NzoFjYPoOTp6VN5zVpYkCVeWZyuFgHwkp2UwC2hFQNxNlAZiZGLkDcByD9hwZto9Ga5LCeYca548
+zigA+O9SErYFG88wRiM3so/aYPB9vAatI5vsdiNNY3WyygqBYfK7y3rM/u1jZvea/fgazP43/1rl
+o+7AUI3osFfhsNF9bcCGSw2HL8z57suJ8aNp2Q7cM+FwsEr7HrFF5wwD0uMFsUBz3hA33ZA3fWEH
+UW6sGvJvkPZAgOvLdtuipzY9teM4PxfWkg2t+YjL/gtoz3O+ItwdcbjDcjTmOUymKUxs6JcDmMVM
+kU+grLH/95V8ZZqzD11Iq3wAL7veBWtd3WqcFg4zhb2SIc3P3RYkdsHiEPAcKOx/KOiTCFzxR/Q6
+vCOWCUCiwqX0t/iOHmd/rsignplsSvcyxoic6aWM5lpNgPr3/tBsRnLx5ddt9Ex/msls+Ytm3Qgt
+MqCSwqfk3C5c9ruJBpBkspFq+FE1NN05CJrRvpxucC+n0FNFG90PPOTzHEeF9PWnu8m2qGMeGTFY
+m+e7cyZjT/J6o0ahkA1YqZANGOpykg2IaH1oPmIWXDwdNFkfSyE7DcZtfzo5hEYxQrD5cSPMbdQH
+2cxE3E/uyFrNTiMDgwyMSoUMjEqFDIx1MDC6jnyOxzPoh8yLsFRH9P9ZqiPfRoV0Z6VCutNjJ0l3
+flZ3fq87/CEy0DBjmdA03lVEpHeWYS/PBCyrVORPDiI13mUiwct+Cp8H+Z0I1xuF65eHT5tpO47I
+2fTqqVTIY1ipkNleqZDZvoLKJOo0uHXQKF59Et4UehUVd5P5+3Gze8UE7+ebU/fxaSpNHf/P6H65
+bjy6Tq1DXsDfktyeMS6of8hSrYkOGI+ZqnFmpPZ+/Kal7Q1orfQVGMP6sKXVmktpOfNSiLbUifTr
+35KiZbpDpT4eVb0t3dtD9KGYv3079aqIAno3rqAlmyCEIdyvAu5XadLYV0is0vVV6b/SVHIERXLF
+TJ7e3Bqen6a9r3f7LK0Zlx3o//3glzdArj0YG/TZMK7NfyeXhjrv0siIz2LpBExRwTeDLQlOy4HT
+TOctEqAmL3xkfjp1z/amyqXdTEjR3KoV7nQ/bZ+DDelnSHILd9wOujZPudO/xJfbpn6DWHMtU985
+y0xvoJW1Ak6LaWApgYvAhQKu6Z89lSOulRyCtEy8YI1QRijDQVlXAGQNIdQTgWr7QBXG5rrO3W/d
+PJQMjyFYkSU/V3ximIuiUj9RMjWXjIZ3rxiwYsyGD+L36IGx5PYgt8fmkGWPD0HllhiSGBIFT3dM
+S2JIYshYDLlgWsAAksc4ybDOBuUzvun1/OOlN378Xvz4SErgO8eOpKUByWXsyXr1WTAq1wlQD8nX
+zdvx3TrBJaxW8R91EFhaB4FCEWSKy0gVQhaeLZEekR6RHpHe0kjvZEbN54aPj42+10WmVBzdQnY1
+qRhSMaRiVoH2XLkxsR6xHrEesd72sN7sQnZyJRDjEeMR420S43Xgr5xroFBa3HtLxi+pAlIFpApW
+SRW4zB/SA6QHSA+QHiA9sL16gJszYDbXZXWuuDpBbtTUntVC4YzystV9mOaSmj1tQFvnydiLphIC
+Ett8MfyYmNlMlezN4C1tyff8Vn5hfFPD4y03B5fc7vsHqozJj8QGxAbEBguwAX6HTBOqp3fMnt0L
+DwNsDpg9U/rWgI44UMqqWxoDSBNuKnEGXl4r6WDeLXblTOljrViaMGM/i3g64NU8YGIzYrMlgR3f
+Pnm2Z1xY0O8+T4IPHplRHL9qpozbKjdoIc6ln6KS3nAv8h+UnLX9S2LB5e7HakyuOlNL3Qd368IB
+Y5FpqZOJG7XV1Y/zjw+prV25S6HUJiZbRFttwpI9NRFP9P3mmqrhhpmxGAkdbc1HzIJ7Xnz51d+3
+21QynIt3/smFLzbky2etLPGVOWs9nbRb8SxY8ijC9X4sOKprC2YflB620m30AXXUvVDP3SLy8v3/
+vwzvzdujuP44J4kOI/hhXLARNBK3Zncm63gOn8u7Wo1TmG6SOpV91ochSLuOJ/G5tKzVOInuQD01
+ksT15mzz5BE0HcSyrkSfGwsahZwq/o+iPhtCxtJWuhqGfPE+k1luC3Gb4d3/uMXpoujchBzOCdDP
+J/BrpCmEMFHj3fP519yBoRptz6rdze7yvoT0Jo+UgmZ4X97ktmuZzc22Zxt5AgH9GT/gOm0zbccF
+ALblQY+xc3y49A1bqf1QeTIobAPalB83paeZNENu/+7ujxE8P9bqybB7gbgxlWWNRW5Yy5KBe5Du
+0PCL4MMvvF3hr8eybnO2X7+8SoDaPkA1sgyYfodyPwmrphJKE6CCA2qeLIXiTLrwVw4fB+2QYHU6
+5MbMcCotOOyaRhBv5xzPUCx1xhJosiFoRpjaNkyFoahL/gCWD4HwtFp4ehCKrakpdcn7A1uGVsIV
+4WoRXLXkg5jYgwSsbQNWGA14xZ4vQfbtgBBFiMJBFJeEKFJ+yCPRn12zN0LUinmopAW53pAiqiKq
+CoGrK5USXYVHVUk+4Lunsp6wcjH5jGmQyZhwtVJq8Dp3B7rOkZo7ntpBN2GCKGu7oLXgPGsBTNNs
+1/e2HyMFS/99rOFHeVfhc6KOYcBG/OPkgAWdmcqRTWtDY8MPTJhAfFOeku825UaK8cz78HGSatkd
+DW35zywLjN9yQekhs8fj32AcJ8X8EcbUYeG7fKb7gfLsz7XKs7kaoDDN2caMfEBpEBa/pq0oOXDk
+VryGBLPxZsKF602xht12N6sXM9XF419VuqB0QemCzqM7Azwjixuo9OY3wAz/YG0LNr5nyaOr/QR6
+tW7Bq9U3svAjMlbFQ+dutCAXXZzdbrPcAG12pM0WbEx7HaHNQlMNMwE21mzFzEPDviqUKM1jPKj9
+Y4qf/W9WaZ7LE4A8Zgao1vtTJuIHG/Te0W60dVj85Mabh1htV1e20ryghZZ8UITnbcDzPFkJr9Dw
+xXQQnTVbX72qtVXqo1soxEhOUT5bIb3CdWu8oiWU9skdWWSuQslVirEr2VQh9D6w05YRbT3hxW1i
+ekyN15f20j4HWyiSOGzFRDZgKxSzmJGCHuNWAjNc9rt2LFbkMXz65oOWvCsnXEMSblT6Yjvz+lGb
+EeaaVYKxYs6LTenfF4LBr8AMepo3XUfubrSZQUMwg5Uai7NREWj8BtDLsC0cMr+CtoZgubKwLDPc
+Xxvgac3GGwDKaZ2YG74XD5Z2IpSQuarz/laKM1+0OVHmSgKTRjlg2wcE9EqFbINNhfkbi4OsjUqF
+rI3lAh7bQXN73vzKBE+L4CyN65hj41rBc3JWa7ku30zJvyem/bTm9/dlTVcsjdWMly/5w72Jve7K
+MpPwGyaDxHYclVCfiq1r2BQSUzN0O0Eqfk3Fm0NZQ0idqCGXTNrGMzcEqpUB1Q/HsqzEtdvWGyU2
+j/7//pPrqryv2POsNy5diKWkcs5+rK40zV5xSYBae0DhE6wDxbYxq3Mi0mVYvWcRl3ZNqZUQtf6I
+wufWF1SsKL+GG4+oJegOS3lOL7rVsjduT/hwiRdiggx/9+063wEaEbrFI0JDwYqGhNKQUFRA3Tw8
+GLCEp+1zM4VCVEdZZmmYIw2JwYMUTfPYtJEL+E+Lc81SDvPkhiw7n+WSjVW+cbks+K1VGvdGidzC
+dH7a5rrZqMnKVkbmzrgQZTXapN7iZ0D8eC5rCKwLpfk3JS0TDcH7cjijiyHBKzq83judNQRZV2l7
+o1MgZ+fqQOv1TNYQUK6IjSfEWasIrL+fzYp08G5kmRi/+6CapyxsnbsdL2z3n0CmYZZBH/0wu2Cb
+ubFq6His7Jdwqv1O4IHlwjrpkouyUr/vLrXZ3/Z6CNEa5H3frc0o+F6zexHCq8XToomYn7Po/Z+i
+ntM/e5JAiJlNIciPRH4kL3b876+rh2xykBKwlwTsYNlUIESbpanrekCvwVVLKFxjUFHO9jYiKkIc
+54o9O3xR/jOVmGBgq2uZtu9mTxOilucOfXsqy8x84amfe+Bnb8K6lvC1pOASKECwejfi55NZS8Nw
+sgh6b6y/dYhPthNsrCrdhroRdBeomO9vV+GSG7ttF6Eh+ZBZKsCiAiwsRDW5TnLB6Fm3dYjCjwI1
+c61B2jbrb30AaG48bfRU3fOc39z/G5I1fZCdes0xI6qM/973Gua2HvCaNTyQoLUsaM2YoIgFq5bM
+couLqHM2hIylxV+mlg30YiBnDDljVpGnuolWQtwNAARx1TZzVQBs9VSeDAhVpAED9ANxY4x7fEjm
++nb0mFmsjOgf+TDrqThTwrKPXW6frAIq8/H87VO+TzmiEqAP56pHQV5LpvAcB358hqhP4m+hAXUf
+ZtkR/CLA7xqet7UKNvpetzWMuMoN7ffn9vt0NCOR79Xz0YdTaUF/YrcrpNRLkbcCx3wJbAR0zJt8
+zF2rsgzSOIeclIbGt/6s8TPEiou8ZRlirgrodJjZcTdjCTSVyIeSqoDI9RUEXh31RNjaOmyFmov1
+b6VpCOdK5UD0XODk9WAoXE2oQgxXL7OOpkD2dlUtTi/CsbJWDek6bFn2RlBMXcIDVcESohAR1eH9
+AUGKIIUIqZ7KCFBUQPrWPfjey2Cp4yADdW9JGJUWrHeuUoDB6++AYqnYD9CVUohebpWDlTnhBjEL
+tKPuhXruJppn9vtv+lyLd3+Rrsky3ZlurpNWArkUlg1BsyuVAgFubdydk67vb45u3eqviy//syVH
+oC1RXfwS7QuepiBXp0B78zsODJj9yg2/F8SzpNijKHY1zHIL+kVLjMA1msNT9PTAwlDkHx/S+iKO
+sLbSWFt7lBV/lMC1YuB6PZU1M83OciFMogEkWWaVCllmYdE27XgyUfhdkMat9mOEEJdtVoVuWHBd
+MHM6An1rIP3aITYjNgsNuJa5dc2nyJ+37J6L5Q691evKuC23I6zKp2tC12S9r8kVMxb0V1cBRk96
+MoMRAMUTrdpaPXAB+g7uu6BHoFG7a5FZPDfpEk8GgXWrTXbEsiC9uXaEsdplnG7EDWkrbemO0B3B
+viPBR2QGuCAqN0BeZzK3Q4LqjGtj26CNoojaCrLulo/eD4//3oDrlPBP+N8S/N/IbpE+8WJ4k6OF
+HC1r7Wi5kfeKaVdyaVzSnACKaZbCufjJlbKl19OF0Qb9oPTQ1UR2LbOGigaITNeaTDvN5oth0IFE
+6fTMtSvtMEuxvhVzPqyhP+tv4HLDTgqAEbi2DFxBig06yhbnM+MFtzWTgqNYeL71Bj8czFq2e3S7
+mv6eM8Ht+BJGIIixVgZdb8+mC9au4Tuia5m2V+yZD/k38sXEfz786GWc7Y6cbIxzmtFzAwX3U3+k
+ch55csIT+LfHF/8G/l3+jdxGBP0VhX6Q51oxSpcaXlRWuFH/Bye0plgjlK0uyuLhC1+NuwreptJw
+nvPWSwiop/p9CgStAAo3/g1VoK9oMUj4I/wtB38XPIWLPO0OlLZJbgl2BLvQsPvaocw1Als0sL2E
+sFrSFrnDY4JdhULai01XPwf7Qw+WYunrOGr94z1d2cn252BfE0ZL80VxpzWr74JbaZhxzT6nN1fq
+5ceXZPa/WRokZ2iXVcbky0AJl0UaaUC8nYokLK4mFv00fGhstqTruNmd2XFzlVXUOvJBS3ZtnnI1
+y09Ne4675x0wZBlsNBuPFE/XnY27P78cZtebrDJJfO44lkMSXbAftQekk4h9EkTWRNarhkl6xqFg
+8dfPbspohkdtGfvxsUVcqZAZhXZTT0cgrf8EieaAyX4srcFN6eiKzUZmOIL2OvYPSrUjY2C+gvHt
+AcQK8MKrzyUyJkqdPVvPC+hjqg1o95CbVouZ2fOqX/7czz/1YXq5yxZvamCTZPG/L7uQ/132j4tb
+y4HYSQICtCouBWpWxvrW3wYoVqBm99GRfaysVcNjpre3PCdkyfk6Yn4LytKmPk5icmLyjWHyc/YN
+uiCg8ORtdebocgmdZtnHBv5YK5OoDIjOic43BtW/wbgIwhGoCdRLAvUxDNiIK40L7Evos2Tckllu
+C6+loSoXqnKJiL8rlTJBrLqiXZLXchCaG7hQBlZquxC97cKP57KOoDoBYWkqf9Dn+gpbeOs7fYnU
+Kz1aNku9txJkpypp+G3G1DUbUcyVYq7bOJbrxbHZkHxYYOYk12x7++LSTfgE9acq/7Bb6frcBNzG
+0NvkjSKqXwuA49k5BG4C94qBG3UiHuF7g6yTtZw1OgKteQrfnR64cQ2yzqNEPj4+xXUbqMf7A0ue
+EmLgLRuaYnNDqCfUbxPqiyEJFDikwOHG2NKuCPYCWNrc4AnA60bUM37wBDINq0fnU/Rsd/N6onKi
+8tlwXrAx8aQQtJtnmdLWxGmI0Z8IvZ75lq8s3g6jxEvwrp4q8vE/nl798QmVwHn+bfkNxs1gnULm
+3ZqXj6F2zUtsHd5UUkJiIZ3eVrMiPSjPtcozn31vaM3Ga7jxJzDiCUybn8zM0Yi8882ic43Hzn/v
+bLOum3+u2azEb9r3QPv+Mg1n3aiml2difXd9xpso9o77hlrefPiat94+B3umktxA2oNne6yeiXSi
+bfxL0+0XS4ceImRzrwIgI7Z5JjDSI+Q9JP4GY9PWYMynWJG2fI4tv2TGvkJ/vewxj2u70ns/KVrO
+rVWSYL+ErS9Krldkx/Gi/iu/7ZcqWaX39nbs/DUb8X6x7eRZjbvzbcGsa5O/Zsr1+2evv6ujWwxw
+OVN6drwJ+ZHzSMGtUA/tWRN5VpoLpsHn7+Gt6bFQMJqe3UtEpTuH2YlruDi0s62tyAiclXS1CvBD
+SyBb3RHBZkqHk7fwiXqSRIiUnVOh7JzVuqW/wTje3STzmdD3A/reeEs/7yydA4jDV7lkNhMk30Ly
+b061qGaLB24IkVuFyA4kIC1ov2okGuOOPTJbpo0su225Wt5ILh3jUT/8STLweH/OFyfxoIJPP2Fk
+WdPFbdyUe48BgNu4L7q888QabgtNWscneEsW30p5qmBm4ekn92TFR01vlQ3mNfT87zVMUYefs/Li
+qU8isiw9fs40eWxErsTxT6uoop58f2blFh168EN/KeGKeup6dt3Y2h37p7Vx8jDD3bAECzpmZHfJ
+14CKipbkh1yNcz/hJqGj366jL1Z7DH0WKSDLnTzSdW/w39YqKQKRs87rk1uz4o/QVbgDUY0+ugV0
+C1byFpzKlO4A3YFtvQP/yIdZB/7KwdgA8e4lL+6HIt2o6k54lAdXKmTpBz3+tuIuq6ORfLJqco5z
+fxoAiBXisRnzcWNwfMYkxctfd4PLZEDgIANglUjy+ySFHsuyWOoxmz3Obw3JYeEjD5ui4QWBaS+z
+orNZBwSwaDniFp7tvXouEbp2b6N1uv9vDz/ysc+WSace8tQnk0SiuT6sk0euD7J8Vu0OXCrZL8qi
+It6Dl2HGJsypl9Xdz1N/H+M2mBkNFJflI5k0dSSCIIK4UiNSknQHtvoOtJmMeANIPf60J5aJnmbS
+CLZZPpNPb80IhEqCJRCv5ZaQMUWKZIUVyYwICKmS8OSQMAEUgIqjPtZxR0h7kPZYXe1RVCsBqY9l
+XYWwhVukQUiDkAYhDRJSg3Qt05bcuXQLtvsWPPEskhllnKgTriEJZzjMqxe6P37T8npt5e74bh6K
+MwGzQteBS0oLJaJIBj2W0WuL7gHdA5a15J3SgtKjl3UbspebcDy+bdFFiH8RXkd+RC2k26iZJ5/3
+NzGRU6OgZcD/jstUPWGVSKz+Ipe1vF9m/B23JKXHb1H74tf42WZ8xwkI2nU8+/nnunkGOincKW/+
+3M8/1WP991f75VrZpgZmXT/NL39f9pf3xb78agcywRPmXFI/LvyXd877P7+8c77vf1aISVct+aDM
+8bj4x9Q0zFgmcVRAPpG4Ea+CzRq69sHPfXhbXv7z5U8OIjXvS/9XHFJYMh/EuO4/q/ePzutHWmhr
+lYH+MKb1Sgs5L4i1fAreG9zMQHEHWFqCrEqlUvlyp7mFjzXIbABrzgT/NoliltwpeamKD3pgwsCv
+M3+yy0bw8pNzfdBXZ8+FG/I+c6zdJ0yLsMD62pk0J71Gg9S7NbY+EPMrzl0ryJWTs9u1Gylm7tAM
+26VMqy2AebwhtysM+tOZPdm3hkStzgnQFc+JSsuYelt4RDOWXkWbCP7xuIhXEERxAX3tvCx+dR8A
+vvbB184PB0nTl7fKE0nTl3EhWKK8CYnLQyJNL5wXijQ5Z67dL5pnvg64isMBGzYz4leU3rqlJgHR
+Y2ULx4vSk+VTT5ZfPz8JPIVV240rldKlXFKY+VVXTpUnpKQ0KxVSmktRmquQYDglpKiZVaSiSEWt
+xhWg1EJKLfz5c7ZIA5AXcQXgv80TsZeQlvm1syn5Vxib4aj3mJmwmxE/wdSL/aKq/BVTcsdcMj3u
+zkhY2YCr/naRX9/Z/59Q/noZ1jEp8usMgK15Co9LzAmTwbMJCTRbxXVUKTdroQmd8lJOeeH8wBPI
+NMxK/IugJJUSpBxJOaIox3lyr441Tx6bSihNGhKROxd6DZQcBalJOmrSlW83jzQmaczoGjNUUR1p
+SwoQkaZcqWNeZy052TjSkKQh42tIZ5vVSUMumzpnHANpSDrmrdeQxcZtvIZsKmmsZlxCSsoyTMeM
+C56mINe0vUCq8r+lh3zMDqvSL+OKPROaVzDWvqZo4pLQRGhCQhNBKYya3UIlSklG86WfzDqHj89j
+9r+hNy+d88Y8ek+KjSt5E374/vXOPP9opf+iFzSp9k17QXNp93fpAU1Pnu0FE72fCUxYYCIkbd3z
+ORBO6fU899zHj47h4+OY/W/o8UzHvClv55a09HAmTU42Iela0rWka0nXhtW1lLtM6jG+eqRI7moQ
+J0Vy6ZxJQ87QkNe5AxopSVKSKEqyWcDFQ0G+AJNUJCJ1lu3+nKdACpJOecvV4839vyGhNySpRxz1
+OE/9a+fDweqkHGNVRXZiDekn1biFZ7zOirHDxqQVSSuiaMWVGMxOntX5PG5mtfqNk3bcxHNeZw1J
+vepJSS7p6fgVEku9k3DJc5GnxcxzICVJ57z1SnK6c7G0JMoXa8OVbCpptRKbMn+qsizzoZGm3Olk
+Ji5z1rXMItkSHXUv1HM30Tyz33/Tx7Z49xeppudfG/3c950Cf5IPs09ouDUd7u13JULv/QWTqYBz
+NoSMpY1nblpu7nQce0MVYcBWulopwcubc/kI4+bKzDr+bfoxS9uN5xWCxYNQbInAGNNWvIj/tjFb
+QbosqC47zq1VMqI2S8E9HkibkTZ77wPuCzjOeoqtG0CIv4LyV1NJCQlxF0Fz5aB5wk1C6CR0rgw6
+uyDTRpKAAK0K2M2MreCCk56p9EytVOiZSmw1D1udazbidkw8RTxFPEU8tbo8NdbKJCqDiEz1V87s
+H3RF327Hn7Qdb7fjn7Qdb7fjjkicSHwGif8GMQ1NbtoajIFVctfcKyUoErIakRBuOpCBy4n6DcaE
+kan0TOR9Ls9zvpXdDYjKPan8SuUGJqHtLfUdLDWmPaaNmAgfvuKQtuRFr52oJ0kKbVMV2qd3RBdm
+T1PlH9L2ut0Z0vHBdPyVGgFFB7Zdxy95K+hRQoTlS1h3AwBBjEWMtdSt4OZM6Sem026ilRBkjROT
+E5P7M3kPnm1RMtEcMM2SuJl0xurN6dBDN5ZubJQbq/JkEPGSWievlW7MFJ9fP89am1PSQzW7lQoZ
+5UTcwYnbMm3bgo2dhRGFth+4gOKft7MFIqHSF5UdSJROP4dLOoVPnYLK6BCWfAgGZDr5koimtWRD
+MBlLgF7Bb0o/LeOCNuSnDfFopUiqfMv5qzeAIUz6xMb0DzipZGluJzy9Wtq6d889Sx6bapgJsLGa
+2zLflpibjtAVg8N3WzcyHjSYXFjCgC8G0Dv+cm1zJopo0xWTrA8au99vzH6+i+npSQZtdUbiKDLq
+M2X4jA/7JO4X7/Jdi29Rn8ADcwzgoJWCtLNbGf/wzQkbgmars4fNM4cRMnD+b+XLpUqYuDWgl2d8
+Ty/1bUZXmq40XenNudI10tN0qelSb9ilJj1NV5qu9GZc6SbLbK5hOkZIgN6+AOoqnILgyeP0FUTc
+StxK3Lpht7pGt5puNd3qTbjVrs68qN+kK01Xmq70JlzpLtjfYBzPV/kI4y0NoxMW/bB4mxESCYlL
+RuKWVzesxBGseWnDzLHJ63EG7uc/7rtKfEx8/O568RL+nNRlDfb/9Zd5hrR/JlMQZb8Mt5u/UzMY
+7u12HcOAjbj6MKDzIxu2tcpAf/ig/r7HjWTGfXt7Y2Zc4Q6w1P2tayVn3tw7zS18/8E5r67mTPBv
+rGD4EkKRl6r4oAcmDPw68ye7bOS+yOoc5vqeD/H1w6bcSDGeSWUfA6qMXr8ykUNAdv24WdN/P6Gx
+w+P5VLqLnW4moB1Mw+B5/eHUZtoaXCy5P7mz5UgqOBSZGuPSXlmfp9dsKGbAHfla4rRKOCWcfoBT
+bLv8DoQbzm2sZlz6Gui//Pg5088ohrf8eAw/Wc0tC8O5bOaXr5wB5cnxfPm/lZ1PXOrvtw/kLG/O
+i6gqgqiW5La4Q5CWiashiDvVWukyQXUEQT0+hPQmt2WydhFkdQe5vXl4KBO1N+c1+v6eOu6BsY69
+uOy7ItvcfPF4G34C5XbggT4UoLMZQXJMmF8qAzHwfaLZUwx433G5ALL94Fa4PAtFExJlDWtZMhiC
+tDtlK8FA2qu4agy83SktFiFU3xPKmVW6A4K511JPBeaDOFrvSlmlYxyO88ep0IcT/AK5qIIQIOIY
+JDpzNkmSC7bIEfntnOD98LxzMwIt2DjGrvU0kyZjGmQyDrdp2YBd/TB7MMCmtVIRiQJGMJzR3wKT
+BRozQ3uYRkFT6XIjZ2dnZ1EISD4sXsBtzdWPcac11gY3GchGlsVAQo9lzQGzPXYfAw1OmpIj0KY4
+tFNp9TjKi4hljRGzTHusE+NV5Pw/bxe6wPNokTWesyG0WR88VrmPI/FCeUs8QJD4skD3ICx/tB8i
+SLxQ80g8wuDmAqinKbdK+0uuLsygWdYdgBCRnj5n3N5x98a+Ys9d/i0KnXYTJsBJbTt7xPqIXdgk
+MRkkNvgudvMkAWPKVoGxeWeMi1wH3DAD9gxsMojg3WkNWT+Ky6UH0B1wbWPo1EaechVDiV6BGcRQ
+npc5i6EvL5iNoUDa4uMGvKh6wz2ExCJqYgGfrwe0q1UUH6m0pSxXRXFeQsLKdw/jGl242GCZoAMU
+5i4HXhUD4ufg4RjBgPgxS8v5u4byQH55V5ZKw2C8ntKm3CmHgYkO7w9sQw9LhWHg4hIevGQdIcm6
+hNLATX0HaxN9hOFQYPLoYbXUa2jPq3K7sr6LohjdPLJSUYdIlksR4i+zXnZQ7AquG4V5rsqdG7tV
+JKL3l4gBlGtIHv0l1lFsDpWLFObYWQyMnmklrb/IPRQdN8/WYrhx7hg3cywSQw01BR/ee+vY3UMU
+A4/Zgb/II5R7KYS3xD0M7nGxCn+JGNzzj3yY+UvE4J5OLv0FolDPEx/6S9xFuZHi0V8iBuu0lZkD
+OBisc8r8uXwPg3NOx/5Kcg+DcU6Hyvpv6j7GbfzKUyh9SOzXPuOrCx+kLs8mQ0mKuTdK5DaKO/Al
+WSVY3kVuVUu6nh+dXAT232sOMo1xQC4sGeNwimsaw1HbkkVw4ApkvoC71g8IRezIdSuE50LQTVZw
+T9iIzjNEAURDW25sS9rFkiTm2MB/KC5te9pZKDjh/RFj8/6McZX+GYzgnnnYyNBkWkmMg3A+lp4r
+34pwHuc6TlpHU3gkxqD4ztlwCDpU9u8xD3/Zfbz/O7FcytWITt6Izut6PH/ybkR38qI1FMcqHf9Y
+4LTGuL7NMtDxwK2ePKXVkDB3ppSNhm+3ukggd/KKs1sM6ItdKp/N3Ee7wZ67iRbG891OrFDeBZNR
+EuC+Q9OD6qs7SAKLzfQRWMU6Pp/trNZQ0emzvjoqPH0kouhQpaxPhK+KwS238lHOaIY5K4ljLoXd
+qe6F9bTlVvBI9U089SijwCnqNWBDvUOPlU5BBy85ubED0DGOxaskGeNUXJOuYw3sMVNcRjFwuhkk
+nAl/oQtjwknoADPB/WwaGkIUM3DjZP5yDZNGzJOh32kop9txLh4nbcMD3ysXrIySSHPCzVDlcpEE
+U88dc1MTgu5VMzdWDWPgbDIveLKklx7mMYIyb8RG8V4U8joql6mf0Dqu0OnW+sneRZatVZaqJ+kn
+fO9Tt6Jrx4FjYEVrGZ7EuBuXKnk849rYNmij5AL3wmvnmkX//+D8G3HjTtM+HHvQ/ed2rM1k8E2L
+Fl+7YzYZRKlYKfpRRKnD0Sx5jMGzZ0oI9RSDVf308h5K2ZyLiX3Q2LWC7UO70ffclpdgHHzqvoYv
+zFOCR7msX0FbnjBxB2mckram0hK0lzicZAlXPA1zSEWJLyrNvylpPfd10UBjE4Q4Fip5DIrF02Fm
+o/T6ONceNQMozWs8XKYY4DvWPI7aONdMcgsx9EbDZAMmbAzF0dIe6Y4YSxL5kMt8GKXzgBJR+g3c
+KZW2BZOPMSItTtil6kcJspxrNvKoT0VRVVymoCcEGyPAcqWMGXetRw4MSnSl6dcmCKePiQOjsTyJ
+Elg5Fjn4ysOpfLGgg4VxnJa/chI4E2HbEkH/nzH0/B+rmkO4QP1a/49QObju2G9clrRlwXNvb6VR
+gifcQnrCmVBR2ua2BRuDnjTP9RaMw7MpE37iMHj295xbP2mLvwOkBT2REb6uQhaDWrjsu8z6Oy5T
+9RQDLjeyKdxt6E4aO1+BMSzOG/JF8pnSQ2atv/A6inDXahN0BxLgI3/Zi6ubAbNNJsQ9Sx6Dg8lp
+6jhPSwAZp0QnysvybsDtIvDzR4ASSgc9ep/6FZQMZni2DZn6iKt+YsOC++8bohhRFiVkf61uZBRv
+4KQMzITKqXAH09Z8xJLxJgWljvP7+zhZUNNFNWTqJ/MzBxU+4OqZFYDSvSMXUUpzjtVzDH3T1pBw
+50aXI3g+gUQNSwbmf14JKSG44Uqe8RRE6I68EZHhF+Kr4V3eKMa5X9QNxW01icCpfl+EC6yoYZZb
+0C/5DJOO2sFVSEQU/gbje8V0eqUiTQ9pCp489pTby2BKZHpq0c7r5rcYR3XCTaKkhMQWabblxtLe
+PqrUY5YOWHnLztreAarYlwlpv8H4ipuhV1LJ3iHqJ1zD0yJfcVBD/Yq2VlYlSvjvwhGq/InnAVwD
+ObBemd61/Z1wX9C1GtjQ8zuqyJCU6VzbgAuElhDQZ6IHAjLl0RR4v44q/iSfDt2bOG7Lxe+GEd/j
+7gTKxe+hinfD0FTuIRaXfC9z9ptHEkFtH5d8b2QHXD+T7ti4ftrlOmcfl3kvmBl47zgu3TWFylPX
+Nt9r3w9wmW5ytYRHT/baAS63nY54Yj2clwfIpAKjKzeuEuQ78+LfkY7LKZPU8rk+YA9Zs/1blTcv
+rR3gkkpz8l9cyUtlyi/YAS63+AxCqh3g8kmHPUrwtZ8PcAnlTivZ/wra+LSBPsTlk+PxiwHbdgHe
+cbl8XFYpkmcgbbXLBdewmuQLlstk4HfWe1VksSfczJx0/yp4L8B6y6Xu40p1XYhOZeqz3gN8yWce
+Tta96iGuYBeEcZX+pYJryNDqgLHaS0nv1erIa5YstwOX1OwjfBdX+JlQygdhNeQb5WzQ0+eMax/Z
++yFkJ5D5tcNFvluuacC4yaRU9ox7AB35hl1Ym/nRWe0IV/KkKMYzw2K/uoMrvSjX91r4fhVnutDE
+keCnKg/2DxFleo6DOtg/QhTqdMa1smeulLRU8sEOsmQvPXlwUEUW66MkDw5qiFLn0RYHB3VEyZ6q
+4uBgF1Foy7z8k8fE94ODvYWjGy+PtglFBM1lmMiKUy/6+loI1xridfOcOytsVOhSPS02qXaBeSQp
+Ly9bqeEI8wxOIkm74P1B+RCehef9TlsbN5JoM3/bsWrbeHnND8qudcA4OARNEXTBade6oPvEbZxK
+78WzuLx3UCsRPDI98SNfchMn2QmYsFGOx021yTxq8WuRGsfXUcoPRYzCzaI/vfFJg13cQtFwnvPg
+SePOAxMnaVzl4Rp/uCIKpYNvVsScoo66z5+jtOPgz8FyiHKtQSbjTTqXdcg49Dwc556J1GToHGzD
+jGWUhO4u2JZMdJG15iUUpZdiljILXuJQhkKC7SptIfWSWEfe1TlE76JUbU12N5SqPWGWda3S0IG/
+cjDhpyn9U8Vp4teSI254woahOOQERhMCuUkSkbuYbHAmcfZKc6B4EqXULWLWdzzFsmH55Q6Ey0kx
+j4vFNcsyD9jarP55pGwoRoivFkJFT+XJYKPJozfIh/euh8tjDGyctFmUyu1iVRlLF4BGSDbcjcqG
+KEmUY8mGPPFHyf4nL1u0a+ZGE2rJRIxL5nItmiwZxJqpYF2WrYmim3lfMmGiDEHK2QWwLN4di3G/
+2oOx4Yn5XocboxPfVKbLPzJRuvFplg14Yop2q+5el+PlEFHsFZiB11qPEIU6gTwR5ZewuoMs1UTp
+2vf9TDNmORMXHqWL1Rqi3B5ozbiM0sLvVeaz9cimqu7iy2wOmGaJT/87lF7SLnXLRGnqN6kz5LLv
+KfMAJaWowM5X9ezBRCiJzec5L8/owAhy+s6vr2FwwDUb8b6fuNriViEIlYG+gqHS4x7rB+7Q5zUr
+DCfRxzxalUUJ5LnHgo3SwGSw2GhhXyzwBMI3heeyLzyrb1HSy3JhefYi0QTzyBd9C49hwEY8QkD8
+dxcviZMVI6Jcou5AZQtcoTnOpp3rTBkI3Hw2tzrOm3fSdEyMYxzOqYThOOzp9ByzhTyaO57aQYyD
+uQA38DMYz6ghl0zaxjM3m9AWRrMHe5NbF2ZOo/BM4XpwfQFGcZpcFitsquGQW48lLqy8nRSXFZ6b
+ZnjPXgxk3OQ2jjfPQ9Ci1HbKDJf9E64nWfthE/a5BBbFoupyCbHSZGPc0N/zOIGM33OPmcy7KII8
+JryijChQuUe7CQw/xqnfIAQM98Xpc6YkSOd2i+FGbXKd5ILpGN7TZn7vEaPe+RTbhW+AejcA2U20
+EsInSofi9xFPbByl8OUaRh5NqRY9oMklenmkxsgMbuRWRSq/8+pYUY2GBpTo3yPPalGSTB95thsl
+8vfIs8Mo8xofeVbdDxVNP5UjrpWLozMxDff1BlpZG5j6rlVTuyE6Ufqne4pa9CV7+pwJ5WKywZ1l
+r4MMYw6jDLVvZ1yIOE+KDvx70Xrm+Uk1cX1CFqrV9t01CzpCuecl9FkSZdJkQ3MWpT17IejYY+Zf
+DSc4qhPoMhkl6eVVms/6dlEldmHI7z2kVvdRxV76uCf3UEW2LBPlD499lBdpqiSPknXDNBsqGWUO
+ZpNpq5SM8kR03sMYOTWuN8ITG0fJpukm/IxHyZ9p6ISlcSZfnjFpmRlHSZdpSMv/yiFKdsy5sgOP
+yqkDNFHePHyIJtJH2VSP8MQJH1fqwqaVkjZwysA3OIzj2f4GR3H6m3yD6k6cBOJvUK1GMaWcpFoU
+E8pJ2o1iwjhJhzEMFyepthvDcHGS6vsxzBYnafcwhtVS7N5hFLul2L5aHLPF7V8tit3iRO3vRLFb
+CpLdX8Rs8dZGTkRYjTQeDsH1sY0yKE9Hqh5rC49if5QOG0ULjFC5EG7C7RlLbOj2gl6L2EHq4eQz
+Y7aK0/5GqOfuXznTUaAwkdfxaWZaR9pKrzG6u0jCPEbpotQRapV1B8xjivWiIY8zzYYQPrjrAoYu
+xz4Ks95EmaZ88/AQKqJbHIoL01wxyfquuj507pcxcWZdd2CkHiFe5CFKKiDIcTClV6QDTxvpnI5A
+2k2p5HC9rovFxRvlHAMMPxxYF2SULo0/CJ0OpEtD9YeZCJskpQbvShuny/Md09KjPzZKzr3XcL5F
+jyaXRfjYzeEzNkJUtLMfRWlU9yK9k8a+PUQWPSHX9L4xYpaFryVqCHEOUn9cS91j/ff/8Mt/vpxA
+piEpcvjf/aF/xSj0cmNFuewXa7mRYry85Sya2nEOTBef3wX7Th/+j7/8wy/+V9DO1iAA7oBlSq45
+djpM9iFd+lKqOOnLLq1qtMzrjFLrqp5A32ZLXEUdtYb7VA5c1xi9xAXtopTTusaWwjXB0blLgFzi
+enCSVBLOxIQCl7YQlMyXnIuUy35PKbHEpaA0kNBMmlnDocMv4/ATGrww2FZbc3t060dpfqeeZEOm
+TTeWJMbj3S8jphYrowhFgXD5bxanJxfT4ygtubj2CDNhUGIjdX4wnwFtGPvnCCtK462zXMpxjKj6
+HRfpnUefiSqSayVOUP3RDf9n+jFKXL2XW+WVpl1fWOFIvfLaxjemgzO22ZVG1E+OYrDzi7DyDCuU
+frwZyPPLGMrgCjyKZTCUwddcPLIoXRmv1UvPtwW0gd9VfGmIGDoI1wHjElxi+NSm3WJD1TJdMJkK
+MOHDyk2QNk6/KZ+p2iiJGj5lHIt6n18r5hqC9+UwdDjxK+hx16OrOUoCn4ecary5lijvHhMleuhO
+yUfW7sKoG4Ge9FiEbgZvbZQgtbQyCof6jC5GMXyYZbPCHriZDF5zv+ooDXomfcQ7kBbGVgceNJhB
+MAy+tBJvK8GTsNNUG1kmeFJ4h/9hVJRkijci/xjGKR59lXirxalMfGZb4wzyfrZt4dHEuI4kzGND
+P4VKN7dWhh+SFafxW0uOmODprY7DjdJ0wCgRZ6DOdE73GeNRZj3d5PbmYdLAN8bbsMeHkHo0TdtD
+cSCrKybHL9xvYjwUp8h8kRnDd3gNXmDBcB427osZglGKcoyY4ybgFOcY8RU0f5gqHC+5KFMo/dIh
+q7VP8P8kISxCz6YojgWmH8FmgiXQBT1aLJFqwcStKEM5fOZuo5RLT5LEovSQaEgmxtbDg1bDOKxL
+lTDBv/k1wP+MYRVr+uiNm393rJ7jmFfSS9bCjrt8yKTi6ffBOsF37yuHpzgevG5+/28PxY/S7s/D
+B1H75BGdcJMJNj7hk7FV4WeCT+S5Lo0nbMj6cTKzJ0354hT0TGU9PIQ+ugtgwg6mGxr84FY5R3uu
+bevwfvDNOmNCuN7OkYpgOrn0KTw4xBN1raaNDaMY7k3Bh/c+lRUoyTtWs4e5logSsGH9VMVxBZ9P
+0sdvsxhG9T/yYeZxcigjCpn0cB5imO9nYuyxJgyj+kwDPDARJdreBa8xC3WkXgIPSg+7lsnU52Lv
+ooQlWBrl0dV94sOhx6owFuXLUfuR7NHq4SfVs6sIDG+GXqvvb5Mo3XJv7MCNUUsGTHIzNA2ZDJSO
+0zu1JRORp+ACBG6IYfr6FaEM1NZvr5sbPN3lNO1DnDezgSivPr9klIXPpphoEjRuaTw2CuNE2lql
+eZyXuKu3dHXEUZLH83tTjCHy8GzVUUpDXJZVqNhkSz6o4GzeU1kMwB0r69HTpxopTawWK00MpbWA
+UMyGwxh381hOVPJYWA9BseYHgp1oigBlRmm46YotKbiEOHmC16olszyK7mmqYs6/jdR+TwarECh2
+LEYTgTi+ef3AElh33/w/FJe2qaEIbgU3oCM263Ez20zmcUIYnsoeu4/SEl14DNZDoYEO2FzHGbrf
+ZrmBKC2oT03CsvKbhFOD54G8Os7MQGUhPVG5xwSyOoYX6IKV57zWUToJurlqpXCvY3ib2qATn8ZX
+GNBoDDPQhnl0mTzEgkeppCOkd0SbaZB2AIaXPl93d7DeFPMIRQm6Gguam9KyzV2cRKG8fFUo/UjV
+cFhe3o1S0selx5r2cK41V+Vd5VC6pgsPWtzF4I9/gi4dZLh7iNKTtNxTg0EcvafS9eyh9IAYaChd
+0R5K+weVl+qtPZR6K16eR76H0yq9NIFrD6X3A4zKOyLvYXDDqdfwqX2cxhnlh4SS6alEuRt3D2We
+Agx54iUNx7IwpjfwKM7GYInTv3ImytP7UTz97tkN2mtpOE8FMD6e/n0MxmiUXq79XSSr81iz5LE8
+DnSENQreS9cfofnMfZeHYgUy7SFpF6VQIQVtElXeE+doD+nc/vJ5Cx2h5KeXSsHg++NSKSgj/0ql
+YHD8SXlyHwq7l4tBMQDLxWDQw3m5GAxSuCgXg8EIrXIxGEzwj3IxGBTwW7kYDA64LBeDQQJX5WIw
+WOC6VAxKju9NuRiU7tzlYlDMunIxGCzQKReD8gIsF4NSz1suBoMFbsvFYLDA13IxGCxwVy4GgwX+
+KA9xYbDAn+ViMFjgn+ViakgPoWauy7uuVGsoUTteHkmr1nax3kCeK0NhBi485ibX9lGyxAVYD1kY
+FPEbjDOW+jiPa3v7aPI8fMi1PbzlebiSa3uHeOJ8PMq1vSM0gT6O5dr+Dp48D/9ybb+KJs/DzVzb
+r+GJ8/E21/braAK9nM61/V00gT6+59r+Hpo8v4hbbR+PYU74iKcea8QjmatcWJ55tDfbx2Mar5hp
+bR+PaXzizrUDPKY59SpEOMCjGj8/f+0Ag208yh8PUJoAe+TW1Q52o/X8PNiLlZ9+sI9UdqM9ZGFQ
+yYUaemSiYRDIqccUwYMjlES+Pngg/XAHSZYX2g+xSiS6A16Ow/oO2uXylFdHWp7rs10qrYqWs+Ul
+bgcrLa3h0SB05xBrbV7SDrCe/Eparcq7++3sYy3PW+IeSjwyM5eqfOh8HSVsc50P/YShFBAkWgnh
+Jw/LQdTNs3Irq15F6wruKa+G0iC+/BVQryJlSmbKeAhDybYGkZVLQkmW1NwjAxrFK94dmw78VS4L
+JUiugZXfMBT3+BXIvFzSEdZIwlJRKF7y07zcY1iv4bSuTD0koaRNlg9JOUSRUyuXg1LxWi+Xg0FG
+Z7vlcjCo6GyvXA5Kx5v9cjkoPXwOyuWgzOM6LJeDQT9npSONakcoAwuqO+WCUPJ1PMYmHeEwTzkl
+oCTynVXLOQElj++sWk4KKBl8Z+Xt+mooyXt3Sot0p7zL0Q6WrGq5rCqWrFq5rBqWrHq5rDqWrN1y
+WbtYssrbRu7vYcnaL5eFhvmDclkHWLIOy2UdYsk6Kpd1hHaXy4njAI84ypnjAI05yjVX9QCNOsq1
+V/UAjTvKNVj1AI08PJrOHqCxR7WcPg7Q6KNazh8HaPxRLSeQAzQCqZYzyAEag9TKGeQQjUFq5Qxy
+iGd7lDPIIRqD1MoZ5BCNQWrlDHKIxiC1cgY5RGOQWjmDHKIxSK2cQQ7RGKRWziCHaAxSK2eQQzQG
+qZczyBEag9TLGeQIjUHq5QxyhPd8KWeQIzQGqZczyBEag9TLGeQIjUHq5QyC92yvlzPIERqD1MsZ
+5AiNQerlDHKExiC75X63HTQG2S33ve2gMchuuf9tB41Bdst9cDt4HpByP9wOGoPslvvidtAYZLfc
+Tb+DxiC75b76HTQG2S132O+gMchuudd+B41B9soZpIrGIHvlDFJFY5C9cgapojHIXjmDVNEYZK+c
+Qap4TtRyBqmiMcheOYNU0Rhkr5xBqmgMslfOIFU0BtkrZ5AqGoPslzNIDY1B9ssZpIbGIPvlDFJD
+Y5D9cgapoTHIfjmD1NAYZL+cQWp4cZhyBqmhMch+OYPU0Bhkv5xBamgMsl/OIDU0BjkoZ5A6GoMc
+lDNIHY1BDsoZpI7GIAflDFJHY5CDcgapozHIQTmD1NEY5KCcQep4odxyBqmjMchBOYPU0RjkoJxB
+6mgMcljOILtoDHJYziC7aAxyWM4gu2gMcljOILtoDHJYziC7aAxyWM4gu2gMcljOILtoDHJYziC7
+eNkg5Qyyi8Ygh+UMsovGIEflDLKHxiBH5Qyyh8YgR+UMsofGIEflDLKHxiBH5Qyyh8YgR+UMgtLv
+9ji3Vsk/PNrs7aBJ+9NDWhVNWsNDWg1N2rGHtDqatE7VQ9wumrhLH3F4qOzUPMTt463OR9wB3urq
+HuIO8VbnI+4ITVzXsvKa+epOFY9VuiAg8RGJQS0nbZb6dCCo7lRrSOK8eitUd6p1JHnlNfvVHZyh
+yG2W+hTtV3dwWvwN8uG9sTx59GCz6j6qRA+GeS8G4DWy6zcYN0MP6poO8o0xqOv02UtUdfHdelI6
+PePCtVoPPXzuBB5YLhYZC+i1mEsm+znrh11Dd2wsDBdfyQIdSvqCm8ECCFhgjsUwE/yBQ9occAkG
+Ykw+/U1pYIvM150LE20ND6BBJmHR4aMM12siqV8vo0VPBx7sREDIQ7ng/UEMJF9ByvM4U4nVUzAS
+hRGIm4cTsIyLLljLZT/s6QBLY1yZntJGxRoZ3dDDaFOjPWRh9UG6hP4Co6MXW5aHrD2UvgpeQ1f3
+F71OfHgf9voozb8paVmUub5fQVueMLHAPfLcLmNPuMkEGwcft9tkQ9AsirY+02wYZRjyzf2/IbFt
+ZbjPKKMamkS/FdY/gQqQER4obcHcAzVOD+g7Vd4Ldg9nbLlg5Y20D3eQxrEn2qNt9yGKS6GptFYp
+pK5hnvCYw4KxwhPOhkqmbZ89re6gIOVMcY/VodQXn2tmSvu+VnHaVLY8BsTvoWR9XDHtMQD6AKUK
+8VwzyT0uAEoK0rHm5R3yDlGiw22499jDQ5RGEl2PAdBVnH4mZ+xelzN+FacNX3eolB34apkDnEep
+FzUiFXMqx4vy0ZTPd0UZvqYcJI1V5W3cD1EYq8E/9Ln0WP99k+P1jyh7rNWTYe4avftj/yqvo0fJ
+GWAWlrqO2g4KI3VmdAeNsYxDFAI6Fyzhyz2P6h5KLkpXqqelLqNeO0RSPjM5JcZajnDavubpcg8E
+JZfwmBkm7DIXcoCiHs+1yuWSDwSlL1uzmLmbXrIRWyr/ouT0XIOHowTn/IXP22oPpbVXw2SDJV+a
+ah2le9IlsIfxzGdplMXg9IjtLv1MUCzISz6EpavIwxrOrIwRDEEu9VQO6zheIp3AGQdR/nTeew/M
+Xq7YK/d24EwEdcNeezzocDLm3DRDbZoiv4/hne/l+l7NIxTFQZ9bzfqgcjOPZJQpmBqGHgHxhaEI
+w3vQZsCz8IlL3FgmEzhmBqIEq4so/E41TrjaiarFgOJEVD1OtNqJ2g2HPTNoM20naRKFMEqSmEfQ
+HaT9KOllbc3NcHHlfgKZhoRZSBd8OWIsYazZkKfLWwRK+IBpJgSIDhtmy1vJEVrSjewLWO5aqjjx
+Wi1Bz7yNEVZSjRUKQ5nPkw1AQwwl1hwLLlPQC2ix+V8PXIDTagvkXHlrzOB22hUYw/pwk9ssj5KH
+OxXYkg9RtOZU3B3T0qVlRslpLSSeaq10qPSeqYzg6GjkVg2ZR+QVZYCd1cCGXPZ9LhVKLRYvPBBp
+qFxtl48nfsgODntYwsbAd9NqEefmeoz0xICdz2DUncUh4KpD9G8wXtE6oQUy6ZPHeMUUhbRca5Cf
+ydH0PKvcwDEM2IgrHbYiyqosi+MWaSqXOSP7Mc6qnZtBJCVb7KCHrDpOCsuZBgjmGFEj6FqXGhkS
+cjdJURXZECLG8ZxKGI4LkcbjwqKM2lXe4hblcvffr0I2xuy6YjJnUTT6jXSznlkSrDjuGuyT0o83
+T3Li5Q56SD2Vxdi0Y2WtGsZ4zx6z5HGBk5n/3ayVtAs8msMUYu4gFXqFeupdKz1kopVSSZT3lk2c
+GZdsrHIbvCYqVuS1iFV3B+ppgV1bTNgFTyEUU9+MQGueQmFztxIlo9jdraur05NWo3f6v+1O66bT
+6v0Z4+QuWucX3gJRXs6nJ63bK2+RKHHKmztveYsyYdul0Nm25kpzG/Y5fcxEFCY8Fip5jAEJX794
+beGz0Ta4N7KbJwkYE+NgmkIZMPZatZkdLDHMg7eUm9zePHSYXGbUCsV6dE0WXI+wa2VPh5kdL285
+daTlnHHJzWD560HJDZ55Yz4bEnN/2/lKchOUaO6YeIzBMv/Ih1koI9ht1R0bZ4pL20hsaJfFuUcx
++w5KBdO9UM/nkZoDTKR1E80zG8etmadcxXBqTlYWyqfZBj3kxvnJivCXe7aEhd8Zf4a0trtz8S3K
+W8xJq9Y8pFWxpO17CFvYeBuMDU9Mlw9zwRxTdEJ7o++4TNVTFEvupvtHjENq3XRjEERDplrxNAZD
+/HGsnm8kxPA8tru7MfyB7W49RraT27h6+bQplGb3nN9+iZCKeP3Hlwhpgjf5mH2JkcI3vUW9r19i
+pNk1B1oNIWGmvAkvTqWRzJ/LuzOgWCvAhuW8hzPjD+49JGGwxImHHJTe4eAhCIMkbu/a5cPCUZ5+
+5drivXF+fjaKYPZB6WFYtx/0uYxhlJyAYOPyBBCUpAzBxpGSMtos9yj2QUnzVcNMgC0XhkE9TVfH
+JDzyBPcWx/b4niWP4bNAmgOmWWJBnyn9xHScDKQXmS6+7CO0iinUJzRbwxToFXSuY0r08SLtfgKa
+oCf+IxM4ezVKxKUHLEq/4bsBN1nAeEtxLs0BCx91ucltjA1rSQ9BNRRBoRLE2srAKTNc9k+4hvA+
+10sugek4WaTSlfNGAcKpZ683jGXl9zyJwdXHKpdJsAzSV9x17VgEZgOXWxdnwlgjHXIZZ17mpDDe
+o4kngqyv3HCrdBRHxzGTcqE8cz/caT7iAqZ1SL/+4heu/DBM+a+waVq2rZVLH4D0T4iSFdjOdTJg
+Blx7U7vQY8/zFFSaJ/ZF2gkkfLMSlKmzA4qo3Rhv5YmovRje/Imo/Rge/Ymogxge/YmowxhO/Ymo
+oxie/UJUdSeKzpvIqkZx7U9k1eL49QtZ9Sie/Yms3Sie/YmsvSi+/Yms/Sj+/Ymsgygu/omswyhu
+/omso0U8/YvJqpWPvEbjjVr5xOtF7bTfcya4HYfvfHSTO2dreXIPykACKb1kLbppnepeUwlRWLTB
+PV3HgiWPgpsor4O7AbfgI2zhrWNjF3OONKgy6ougrd0jJ0oGYBu0CzsyD/fNor7cDrjM/DOegghd
+13DGdRx0t6RHO5kqzvwcr6FUtR3UWFbpsw1F3KVHzkjt3QH7cwAvSkFNZmQUR3XKHx6CEWqxXVz2
+e2Csi0AzzY2SV2AHKt2U7h4nPkkcGJ29VQwnyzU8R4nFdoHpZBDDwdIFGSwPoQM21/I3GAe3Fq5V
+B0ag72Mg+tzNweOJn0AUk4GlKaRNiNNqo6NUlGGmx8wOtIrTieCSj7js+6xsD6eXjAQfYRhegUae
+uuiPR5PnA5y4bQLaXniUs6JMl2IjiOFObGiQcTKFL5jsMx3Fl9hkOgNrIXWn9cTGUZyKvrLqWPfM
+zZ/kqUf4E4NHGkLAOIpj8Uxp8HjKo0zw5HYcxal4pXJpGZcmilvx95xpPY7iVWwL5pFYgDMqQz9y
+2b9UdhG34gLG4RPoNs/KXRUY3HHrnkMzJ+N9F1df2CJ1Rlv4xgIgIInyvOomTESqXLUe03ZR+t2q
+UZTWzj3NpClKAGLkfCuhdJsnj+XgxlAfLzNi/CSizFDUKs9imKC3su8jKlYByqL1Jx1+f69kT6mw
+sZuWHIE2USrp/bJHcVJ7i0X1WAY6Tn3IZG3dv3Kmw7ntlRA3Dw/BG4xdqcm4rQ644uxRFGxMfOq+
+Ehd2qDodFSOw1+j3NfSZjbJ3XckyM1BRcqUvxveaB+tf3sml5UO4lamK0xaZjWI1qHWi7pQWUSqg
+nLAFO5Z4nZP7+5Mw74on4UYNEr/Nt4iSPPpWYJQU0rcCoySSvhUYJZ30rcAoSaVvBUZJLX0rMEqC
+6VuBUdJM3wqMkmz6w8VfKOfUm3XTqawu2KLJftihARpseZARRWEJnkTxUvS4iOKjOONxSnK1ClaJ
+W3iOglvKl26Sf8Iy8KmbxklxmQr0qpuuYkrsgjRKR2mMoLTVjEdKRfBZ1eIo1ADyRnOQk7fbRvTW
+7iZaCXEcxxvScAE3E+rN9n0pLWnABj2dKH3VonSv/uPPgPfFpS/Hqgg/lV4DzVBKZnMbp4HNjRRc
+er2fF74zoEegXWZGHD/Hi1/tjyjt579Li3JfX6T9+Wew0+LfoOhHoBmXNrA15EZI3OjFGqfPH7+I
+1EO3mZsZI2WW0tXc7+SVnh5GWKOjq3IZhUOPVaTJbMdqeB8Dwcca2GOUl5bwGF2MEgj2mdCwh/Pm
+ln2fuME+Sl5KH2I4m9oe+D5EirnEcC11M8FslMTDroUsStqhE3QjoyQbdp+U8pjsW0dpspJY5ZFO
+trBd71REcG+Me8PpKG6YCxWnof6pTON0ouzDbRZDETlJJ667fQxdNGD2QtlHGC+gkPxgnUHCmQg9
+rbinslj+xZ7KonkWJ6MaY3WcnEhbtN2kHxzcnJ2m0jKwyd1WrpBueQ8SpBZ5DzPHz67wu2oyUWe1
+cwdi1kBy4+VGw5nO5Vzp0wkcRQPlYH4Tq4ENuewXUoKnrZ2mPE6XNcHGk02MYlgINp64C+MN6WmL
+vM+jGBi3BrSftL1I6br7C4PdeXNPmGUO6CK4pX7FuHT9rV2ucxzfUY9bAfdxQlYnWmWph5FbQzHY
+lLA8iu1+rSx/4Mkkjhmjdts3zLiHKcwflhiepR6791jgAY6kGK6laeI/u4/hX/ourKcyn3A0ihM6
+t1bJKL6mgiX9xNXQdtNTII7XCZ5crof/lavuYjXbOeMg0jkEY3CMU5dRal57zh73koZSNc8s9JUe
+ewnE4BgX3J6mEBr/pR5hS/bTvCiFsafFqEGlPUiuVkWU5ywZH3ddrYZbRHimffIXUCJjudYg7RXT
+HpWEtV0UR5RXgBzFqBmwVD2VikJp3+njWKth0M0J06URRpTO81cepUJHSGq+59N5CSVQlt97yaoi
+vrm8BOLE0x0KvcTVURxfwyGkXuJw2vzIRy9hGNRxx7Qserd5yMPgj1OtlR9QDlBMwAflJewQKcWY
+uyQvL4lHOI9ZntkuT+GOp/3y8Q27O2hC/U3q3SqaUJ+N3a3hbWzR/8JV4vrIrePLnWOTd9Gkn3GZ
+LvIFe2hfcMVsMuCyf6f0Ql+yj/YlNxlo5jGVZvcATeR17jNzZ/cQD21We6Qj7eLxVVMNhx5xiT08
+smpryCZTbsqPcg+Prn6D8ZMqH+y4h8dYxzkXtiXPcpn4eG/38DhrakeUSsTjqcKSKJWHx0pFZ3KT
+sfISwD08AurkwqPVDspzC+7zfh/09KHsWoWUyj1ElFscp5dUPCY6LZpOzrHiI5QV84eHMy6gzezA
+x7LY30ES6oRd5PLRWcWlQquIQq9Vc8BkeW7tfg1RaCNNuQ8J7tcRhZ6AAC+hu4hCu5AxPXEbese0
+9gIc7xzi9wMc9BziDwIc+RzisVjEUdZ1ecvc/SNceQvg7WAH9xPmh9xBFfcL5kfdQQ33C+YH3kEd
+WX3NIXoXW7SXe/1gDynBuCUT5bLEjvtF+KJU7j6yXMc0fpIPkCTf5Lav5ljxIbJc/xUfIUl2OVHa
+5W9egTGsD17SD1GeqS+MWiqtipba4HeBDmtoAn1s3cM6buDfb404VQiQPEI6Z77B4R62aL8V7yOL
+bcmUJ14AxqCnC2Ap6C54OTkOkWKEwifyeYjyOC0Szz1yAI5wrComVN8vHeioiizQhxOOashC/e7I
+UR1NrH+C19FuAKFem7yHFG47ZnrqC/RnwaN9dOFeaz74VBp1144FnOc8nRoKG1LmMYm4xCnzaOti
+8nyMBGrf6pU6StmoRyXELhL4rlTKH3jgUrSGa6MqmUuI6+ZZprQ1UTpBaBfXLtbqK7aKkgHvK23x
+qqOxgI1oEnbBPRy7WBX5GZf9K2XjtKfzErRwDWmuH1gSq2PPGX+G1HUIilJ6xp9BmDZox1TBmjtP
+9u88513+rUBF4MK97lApG6XN6Ln7UxEu1B2IKJrXwcDEULstKTxyoFCGd3A3uoGJGBVLXgS7H4vv
+DmIzOUqiS3F3r9VNbl3TPBOu+fGElYLXVXq1N8CZ/v8QpUfEbRaDiXxKHOqRSngXNv+feAZx2mZ2
+1NMV+7eKMqG6qUQ+lF7iFjUZihKiQkLjmZugO1eMwbb8nguPqYQYyP6qnkFEscKLUpMrFqUS+RL6
+LBmvdwOUs9zmGpa3hkV5pgfJQCqh+oF7HoEA99LuqW7GnuTxOFa3z1fBbcESaEljmYzU3v9VtOYj
+ZsGvdUYNUXSbaTtumLFMoswonEq9lY9ysaZgnpCdiLkCO1Bp2Ndgnrh04hhAPWNc5DoKMF3Z67Wy
+Zz4++xqSvFOZxnHKOmFnuRALoG+BByLL7UBp/g3SGG/EM6GUxzaihFnMyzXzSPc++ORN7oBxUY+w
+Xcf/ysFYSM+0GvoRMU4jU6YjBVnuGHdndaZ0PD3jSCvOtW7JtlZ97UHGn1Ur3WJ0cNgGkIUtEqcB
+ZFSLR3XAOPClC0NwrqMK7oBp5/eCJy5pLE6YtDATfcQtvnPPdjIssamkdcHzDQlnu1hevw/GeUvi
+BA+9CvhqKN07GBdRuvkOFnNozS+IGeNVMvcJlBfZgcEJIlb4tWE9ekdXP7FdPZ3LJLTii9X2uAnS
+xrmbi3ZX9j6XPxqC92VRSBu4J/XqHAxeN+qQavTPOCcTcfDlnWZZjLPpWp8pyJ85m1yHb/F7reRF
+PmRS8TSOgfNd3I3OBkzGeVX6LrGGKMt3ffXPIeT3HPQ4/PT93Koo6TjOpNdQPut7p4onrVYurYYn
+rV4urY4nbbdcGoYt3BtoYKmJMqN9KivKePaprCiT2aeyogxln8qKMjz8BRv7i7QZ9aPCQkJbKeEm
+OPCwM7xPn62GYZSR16cCnCslvZF+niiUgpeFvR2+R6WsS2zqtzVX2mUcBLU7+TfYPXzePYziJuff
+oHq481w93IligLq11Xaed2s7UTIj+TfY33ne34kyAdtt5c7Oc3VnJ0pXdydub+e5urcTpa87/wb1
+vdpzfa8WanhCb5AP7yXjwskKesVcfWPXY+YhigNpxCzTx7mJ4smeSPu+k8Geei8CglvxZ8xEOaZj
+kMlg6NESF6ksK+HhRu70ePLYVLm0XTbMBERI1yAv1nwHpLKpgA0JAp0pITwaZKMMrmLG8CQGBm70
+PbdMBHOGqDwZNNkQNLtSI3D+zOCus4iQKFSEsR7zklGeAG2Wxnlc58P7jKXRhk33lANHDIPyZCzZ
+kCf+57b3GeBHg3zT5WB45MrgJNk7fbtY2pHf1j0ByOncxKDxwlnRtfXIC5813mB1Z2LetnoD56kJ
+ebi3fNp/yavDK8ZZfJfo0xlz4a17ERI+9wAiJTE2TBTl2RBPbBysFP82E4ql02k/wZ/1sYr2LpiM
+EgYs8hx8hC18PAZ0czInKOTZHIPHeM8dnEZzkRomRBoqPzFg1r2s0sGsyAwLn+V7pXIDk85M1RiI
+eyOvFgN3b+TV4/TpyA3cDSAOCAtpL6+DKHFF9xyJUZb/G4zvFdNpjADmmUpyE2N0aCNxryuthuDj
+mUNp3zHWKsrYUFfQlLG0GmVo6FRYLcrA0KmwepQxoVNhu1FGg06F7UUZDzoVth9lOuhU2EG0yaAZ
+Sw+jDAP9njQeZQRoIWkaEokxAtTHqKrVPmtVBX/zxsogD5rX/bVTKHvnvw0bDcvj5A1/5drmTMR1
+tTeO//gz1GP09YDC+4W9Yok4pYDZ+ocsv4K2PGEiTu59rLZIi/Z5mmvLuolWQhwz3VaGB28qdMl0
+P0plVHfIRJSWOA4MPc3dEKtolUWe8uoRvYm7Mb2Ji8b2vvJ7zRzGJ73nggJdPXXAKJH7NKlH8fNA
+yvOhv0yUKhDeH/hLXJjceQrq95y5blovY/kDN4VIlE49GlygZFsJNvYQVYtk7C+sTibG3uT9ErzG
+Cvp/xLhTf0SpGIf+nzFO/88oTnro/zOGqvhnKBVxxyzoOA0WYxWJRzOFJiooTlNhrWQ/BqCv2HOo
+oFMBtTOlk7BkeS7UPRNRgMbvxWJa02u//tmSKTwfw4CN+A/W2y9vfsu9a4y7uU7Of3/5/wcAAreK
+Rj5/IwA=
+`
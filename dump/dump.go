@@ -0,0 +1,50 @@
+// The dump package provides an API dump that is bundled with rbxmk, allowing a
+// root descriptor to be acquired without a network or file system.
+package dump
+
+//go:generate go run gen.go -o data.go ../rbxmk/testdata/dump.desc.json
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"strings"
+	"sync"
+
+	"github.com/anaminus/rbxmk/rtypes"
+	"github.com/robloxapi/rbxdump"
+	"github.com/robloxapi/rbxdump/json"
+)
+
+var (
+	once sync.Once
+	root *rbxdump.Root
+	err  error
+)
+
+// decode decodes the bundled data into a rbxdump.Root.
+func decode() (*rbxdump.Root, error) {
+	b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(data))
+	if err != nil {
+		return nil, err
+	}
+	r, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return json.Decode(r)
+}
+
+// Load returns a root descriptor of the bundled API dump. The dump is decoded
+// only once, and each call returns a separate copy. The enum types of the
+// descriptor are generated.
+func Load() (*rtypes.RootDesc, error) {
+	once.Do(func() { root, err = decode() })
+	if err != nil {
+		return nil, err
+	}
+	desc := &rtypes.RootDesc{Root: root.Copy()}
+	desc.GenerateEnumTypes()
+	return desc, nil
+}
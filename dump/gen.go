@@ -0,0 +1,71 @@
+//go:build ignore
+// +build ignore
+
+// The gen program generates a Go source file containing an API dump, which is
+// compressed and encoded as a string constant.
+//
+// Usage:
+//
+//	go run gen.go -o OUTPUT INPUT
+//
+// INPUT is an API dump in the JSON format. The Version constant of the output
+// is set to the SHA-256 hash of the content of INPUT.
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/anaminus/but"
+	"github.com/robloxapi/rbxdump/json"
+)
+
+const lineWidth = 76
+
+func main() {
+	var output string
+	flag.StringVar(&output, "o", "data.go", "Path to the output file.")
+	flag.Parse()
+	if flag.NArg() < 1 {
+		but.Fatal("input file expected")
+	}
+
+	input, err := ioutil.ReadFile(flag.Arg(0))
+	but.IfFatal(err, "read input")
+
+	// Verify that the input is a valid dump.
+	_, err = json.Decode(bytes.NewReader(input))
+	but.IfFatal(err, "decode input")
+
+	var buf bytes.Buffer
+	z, _ := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	_, err = z.Write(input)
+	but.IfFatal(err, "compress input")
+	but.IfFatal(z.Close(), "compress input")
+	data := base64.StdEncoding.EncodeToString(buf.Bytes())
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by gen.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package dump\n\n")
+	fmt.Fprintf(&out, "// Version identifies the bundled API dump. It is the SHA-256 hash of the\n")
+	fmt.Fprintf(&out, "// dump in the JSON format.\n")
+	fmt.Fprintf(&out, "const Version = %q\n\n", fmt.Sprintf("%x", sha256.Sum256(input)))
+	fmt.Fprintf(&out, "// data is the API dump, compressed with gzip, and encoded in base64.\n")
+	fmt.Fprintf(&out, "const data = `\n")
+	for i := 0; i < len(data); i += lineWidth {
+		j := i + lineWidth
+		if j > len(data) {
+			j = len(data)
+		}
+		out.WriteString(data[i:j])
+		out.WriteByte('\n')
+	}
+	fmt.Fprintf(&out, "`\n")
+
+	but.IfFatal(ioutil.WriteFile(output, out.Bytes(), 0666), "write output")
+}
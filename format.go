@@ -1,7 +1,9 @@
 package rbxmk
 
 import (
+	"github.com/anaminus/rbxmk/rtypes"
 	"github.com/robloxapi/types"
+	lua "github.com/yuin/gopher-lua"
 )
 
 // Format defines a format for encoding between a sequence of bytes and a
@@ -18,9 +20,108 @@ type Format struct {
 	// Decode receives a sequence of bytes an decodes it into a value of a
 	// single type.
 	Decode func(opt FormatOptions, b []byte) (types.Value, error)
+
+	// Compress and Decompress, if both are set, mark the format as a
+	// compression format. In addition to being used directly, a compression
+	// format can be layered over any other format by joining their names, as
+	// in "rbxl.gz".
+	Compress   func(b []byte) ([]byte, error)
+	Decompress func(b []byte) ([]byte, error)
+}
+
+// IsCompression returns whether the format is a compression format.
+func (f Format) IsCompression() bool {
+	return f.Compress != nil && f.Decompress != nil
+}
+
+// layer returns a format that encodes with inner and compresses the result
+// with f, and that decompresses with f and decodes the result with inner. The
+// name of the returned format is the name of inner joined with the name of f.
+func (f Format) layer(inner Format) Format {
+	format := Format{Name: inner.Name + "." + f.Name}
+	if inner.Encode != nil {
+		format.Encode = func(opt FormatOptions, v types.Value) (b []byte, err error) {
+			if b, err = inner.Encode(opt, v); err != nil {
+				return nil, err
+			}
+			return f.Compress(b)
+		}
+	}
+	if inner.Decode != nil {
+		format.Decode = func(opt FormatOptions, b []byte) (v types.Value, err error) {
+			if b, err = f.Decompress(b); err != nil {
+				return nil, err
+			}
+			return inner.Decode(opt, b)
+		}
+	}
+	return format
 }
 
 // FormatOptions contains options to be passed to Format.Encode and
 // Format.Decode.
 type FormatOptions struct {
+	// Desc is the root descriptor used by options that require a descriptor.
+	// Such options have no effect if Desc is nil.
+	Desc *rtypes.RootDesc
+
+	// Normalize indicates whether instances should be prepared for saving
+	// before they are encoded. Properties that Desc describes as not saveable
+	// are excluded, and other values are converted to their declared type.
+	Normalize bool
+
+	// Coerce indicates whether decoded instances should have their property
+	// values converted to the types declared by Desc.
+	Coerce bool
+
+	// Problems, if not nil, receives the values that could not be coerced while
+	// decoding.
+	Problems *[]rtypes.Problem
+}
+
+// PullFormatOptions gets from s.L an optional table of format options at n. The
+// Desc field defaults to the global descriptor. If Coerce is set, then Problems
+// is set to a new list. Coerce requires a descriptor, so an error is raised if
+// Coerce is set and no descriptor is available.
+func (s State) PullFormatOptions(n int) (opt FormatOptions) {
+	opt.Desc = s.Desc(nil)
+	switch v := s.L.Get(n).(type) {
+	case *lua.LNilType:
+		return opt
+	case *lua.LTable:
+		if lv := v.RawGetString("desc"); lv != lua.LNil {
+			rfl := s.Reflector("RootDesc")
+			desc, err := rfl.PullFrom(s, rfl, lv)
+			if err != nil {
+				s.L.ArgError(n, "field desc: "+err.Error())
+				return opt
+			}
+			opt.Desc = desc.(*rtypes.RootDesc)
+		}
+		switch lv := v.RawGetString("normalize").(type) {
+		case *lua.LNilType:
+		case lua.LBool:
+			opt.Normalize = bool(lv)
+		default:
+			s.L.ArgError(n, "field normalize: bool expected")
+		}
+		switch lv := v.RawGetString("coerce").(type) {
+		case *lua.LNilType:
+		case lua.LBool:
+			opt.Coerce = bool(lv)
+		default:
+			s.L.ArgError(n, "field coerce: bool expected")
+		}
+		if opt.Coerce {
+			if opt.Desc == nil {
+				s.L.ArgError(n, "field coerce: no descriptor")
+				return opt
+			}
+			opt.Problems = &[]rtypes.Problem{}
+		}
+		return opt
+	default:
+		TypeError(s.L, n, "table")
+		return opt
+	}
 }
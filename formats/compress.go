@@ -0,0 +1,100 @@
+package formats
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+
+	"github.com/anaminus/rbxmk"
+	"github.com/anaminus/rbxmk/rtypes"
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+	"github.com/robloxapi/types"
+)
+
+// compression returns a compression format with the given name. When used
+// directly, the format decodes into a BinaryString, and encodes any
+// Stringlike value.
+func compression(name string, compress func(w io.Writer) (io.WriteCloser, error), decompress func(r io.Reader) (io.ReadCloser, error)) rbxmk.Format {
+	format := rbxmk.Format{
+		Name: name,
+		Compress: func(b []byte) ([]byte, error) {
+			var buf bytes.Buffer
+			w, err := compress(&buf)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := w.Write(b); err != nil {
+				w.Close()
+				return nil, err
+			}
+			if err := w.Close(); err != nil {
+				return nil, err
+			}
+			return buf.Bytes(), nil
+		},
+		Decompress: func(b []byte) ([]byte, error) {
+			r, err := decompress(bytes.NewReader(b))
+			if err != nil {
+				return nil, err
+			}
+			defer r.Close()
+			return ioutil.ReadAll(r)
+		},
+	}
+	format.Decode = func(f rbxmk.FormatOptions, b []byte) (v types.Value, err error) {
+		if b, err = format.Decompress(b); err != nil {
+			return nil, err
+		}
+		return types.BinaryString(b), nil
+	}
+	format.Encode = func(f rbxmk.FormatOptions, v types.Value) (b []byte, err error) {
+		s := rtypes.Stringlike{Value: v}
+		if !s.IsStringlike() {
+			return nil, cannotEncode(v)
+		}
+		return format.Compress([]byte(s.Stringlike()))
+	}
+	return format
+}
+
+func init() { register(Gzip) }
+func Gzip() rbxmk.Format {
+	return compression("gz",
+		func(w io.Writer) (io.WriteCloser, error) {
+			return gzip.NewWriter(w), nil
+		},
+		func(r io.Reader) (io.ReadCloser, error) {
+			return gzip.NewReader(r)
+		},
+	)
+}
+
+func init() { register(Zstd) }
+func Zstd() rbxmk.Format {
+	return compression("zst",
+		func(w io.Writer) (io.WriteCloser, error) {
+			return zstd.NewWriter(w)
+		},
+		func(r io.Reader) (io.ReadCloser, error) {
+			d, err := zstd.NewReader(r)
+			if err != nil {
+				return nil, err
+			}
+			return d.IOReadCloser(), nil
+		},
+	)
+}
+
+func init() { register(Brotli) }
+func Brotli() rbxmk.Format {
+	return compression("br",
+		func(w io.Writer) (io.WriteCloser, error) {
+			return brotli.NewWriter(w), nil
+		},
+		func(r io.Reader) (io.ReadCloser, error) {
+			return ioutil.NopCloser(brotli.NewReader(r)), nil
+		},
+	)
+}
@@ -0,0 +1,143 @@
+package formats
+
+import (
+	"github.com/anaminus/rbxmk/rtypes"
+	"github.com/robloxapi/rbxdump"
+)
+
+// typeKind is a kind of descriptor type, independent of the language a
+// declaration is generated for.
+type typeKind int
+
+const (
+	kindAny        typeKind = iota // Unknown or variant type.
+	kindVoid                       // No value.
+	kindBool                       // Boolean.
+	kindNumber                     // Integer or floating-point number.
+	kindString                     // Any string-like type.
+	kindFunction                   // Function.
+	kindArray                      // Array of any value.
+	kindDictionary                 // Table with string keys.
+	kindMap                        // Table with any keys.
+	kindTuple                      // Variable number of values.
+	kindObjects                    // Array of instances.
+	kindClass                      // Instance of a class.
+	kindEnum                       // Item of an enum.
+	kindData                       // Other data type.
+)
+
+// resolveType returns the kind of t.
+func resolveType(t rbxdump.Type) typeKind {
+	switch t.Category {
+	case "Class":
+		return kindClass
+	case "Enum":
+		return kindEnum
+	}
+	switch t.Name {
+	case "", "Variant":
+		return kindAny
+	case "void", "null":
+		return kindVoid
+	case "bool":
+		return kindBool
+	case "int", "int64", "float", "double":
+		return kindNumber
+	case "string", "Content", "BinaryString", "ProtectedString", "SharedString":
+		return kindString
+	case "Function":
+		return kindFunction
+	case "Array":
+		return kindArray
+	case "Dictionary":
+		return kindDictionary
+	case "Map":
+		return kindMap
+	case "Tuple":
+		return kindTuple
+	case "Objects":
+		return kindObjects
+	}
+	return kindData
+}
+
+// typeMap maps descriptor types to the types of a particular language.
+type typeMap struct {
+	// Kinds maps each kind to a type. Not used for kindClass, kindEnum, and
+	// kindData.
+	Kinds map[typeKind]string
+	// Class returns the type of an instance of the named class.
+	Class func(name string) string
+	// Enum returns the type of an item of the named enum.
+	Enum func(name string) string
+	// Data returns the type of the named data type.
+	Data func(name string) string
+}
+
+// Type returns the type corresponding to t.
+func (m typeMap) Type(t rbxdump.Type) string {
+	switch kind := resolveType(t); kind {
+	case kindClass:
+		return m.Class(t.Name)
+	case kindEnum:
+		return m.Enum(t.Name)
+	case kindData:
+		return m.Data(t.Name)
+	default:
+		return m.Kinds[kind]
+	}
+}
+
+// sortClasses returns the classes of desc ordered such that each class appears
+// after its superclass, with sibling classes sorted by name. A class with a
+// superclass that is not described is treated as a root.
+func sortClasses(desc *rtypes.RootDesc) []*rbxdump.Class {
+	classes := desc.GetClasses()
+	subclasses := map[string][]*rbxdump.Class{}
+	var roots []*rbxdump.Class
+	for _, class := range classes {
+		if class.Superclass == "" || desc.Classes[class.Superclass] == nil {
+			roots = append(roots, class)
+			continue
+		}
+		subclasses[class.Superclass] = append(subclasses[class.Superclass], class)
+	}
+	sorted := make([]*rbxdump.Class, 0, len(classes))
+	visited := map[*rbxdump.Class]bool{}
+	var visit func(class *rbxdump.Class)
+	visit = func(class *rbxdump.Class) {
+		if visited[class] {
+			return
+		}
+		visited[class] = true
+		sorted = append(sorted, class)
+		for _, sub := range subclasses[class.Name] {
+			visit(sub)
+		}
+	}
+	for _, class := range roots {
+		visit(class)
+	}
+	// Classes in an inheritance cycle are unreachable from a root.
+	for _, class := range classes {
+		visit(class)
+	}
+	return sorted
+}
+
+// isIdent returns whether s is a valid identifier that is not one of the given
+// keywords.
+func isIdent(s string, keywords map[string]bool) bool {
+	if s == "" || keywords[s] {
+		return false
+	}
+	for i, c := range s {
+		switch {
+		case c == '_', 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z':
+		case '0' <= c && c <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -32,6 +32,23 @@ func Desc() rbxmk.Format {
 	}
 }
 
+func init() { register(DescTXT) }
+func DescTXT() rbxmk.Format {
+	return rbxmk.Format{
+		Name: "desc.txt",
+		Decode: func(f rbxmk.FormatOptions, b []byte) (v types.Value, err error) {
+			return decodeLegacy(b)
+		},
+		Encode: func(f rbxmk.FormatOptions, v types.Value) (b []byte, err error) {
+			desc, ok := v.(*rtypes.RootDesc)
+			if !ok {
+				return nil, cannotEncode(v)
+			}
+			return encodeLegacy(desc)
+		},
+	}
+}
+
 func init() { register(DescPatch) }
 func DescPatch() rbxmk.Format {
 	return rbxmk.Format{
@@ -0,0 +1,356 @@
+package formats
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/anaminus/rbxmk"
+	"github.com/anaminus/rbxmk/rtypes"
+	"github.com/robloxapi/rbxdump"
+	"github.com/robloxapi/types"
+)
+
+// docSpan is a span of inline text within a document.
+type docSpan struct {
+	Text string
+	Link string // Anchor to link to, if not empty.
+	Code bool   // Whether the text is code.
+}
+
+// docWriter renders the elements of a document.
+type docWriter interface {
+	Heading(level int, id, text string)
+	Paragraph(spans ...docSpan)
+	List(items [][]docSpan)
+	Table(header []string, rows [][][]docSpan)
+}
+
+func classAnchor(name string) string { return "class-" + strings.ToLower(name) }
+func enumAnchor(name string) string  { return "enum-" + strings.ToLower(name) }
+
+// docType returns a span for t, linking to its class or enum if it is
+// described by desc.
+func docType(desc *rtypes.RootDesc, t rbxdump.Type) docSpan {
+	span := docSpan{Text: t.Name, Code: true}
+	switch t.Category {
+	case "Class":
+		if desc.Classes[t.Name] != nil {
+			span.Link = classAnchor(t.Name)
+		}
+	case "Enum":
+		if desc.Enums[t.Name] != nil {
+			span.Link = enumAnchor(t.Name)
+		}
+	}
+	return span
+}
+
+// docSignature returns the name and parameters of a member.
+func docSignature(name string, params []rbxdump.Parameter) string {
+	s := make([]string, len(params))
+	for i, param := range params {
+		s[i] = param.Name + ": " + param.Type.Name
+		if param.Optional {
+			s[i] += " = " + param.Default
+		}
+	}
+	return name + "(" + strings.Join(s, ", ") + ")"
+}
+
+// docSecurity formats one or two security contexts, omitting the default.
+func docSecurity(read, write string) string {
+	if read == "None" {
+		read = ""
+	}
+	if write == "None" {
+		write = ""
+	}
+	switch {
+	case read == write:
+		return read
+	case read == "":
+		return "Write: " + write
+	case write == "":
+		return "Read: " + read
+	}
+	return "Read: " + read + ", Write: " + write
+}
+
+// docTags returns a span of tags, or an empty span if there are none.
+func docTags(tags []string) docSpan {
+	return docSpan{Text: strings.Join(tags, ", ")}
+}
+
+// encodeDoc writes documentation for each class, member, and enum of desc.
+func encodeDoc(w docWriter, desc *rtypes.RootDesc) {
+	classes := desc.GetClasses()
+	enums := desc.GetEnums()
+
+	w.Heading(1, "", "API Reference")
+	if len(classes) > 0 {
+		w.Heading(2, "classes", "Classes")
+		items := make([][]docSpan, len(classes))
+		for i, class := range classes {
+			items[i] = []docSpan{{Text: class.Name, Link: classAnchor(class.Name)}}
+		}
+		w.List(items)
+	}
+	if len(enums) > 0 {
+		w.Heading(2, "enums", "Enums")
+		items := make([][]docSpan, len(enums))
+		for i, enum := range enums {
+			items[i] = []docSpan{{Text: enum.Name, Link: enumAnchor(enum.Name)}}
+		}
+		w.List(items)
+	}
+
+	for _, class := range classes {
+		w.Heading(2, classAnchor(class.Name), "Class "+class.Name)
+		if desc.Classes[class.Superclass] != nil {
+			w.Paragraph(docSpan{Text: "Superclass: "}, docSpan{Text: class.Superclass, Link: classAnchor(class.Superclass)})
+		}
+		if subs := desc.Subclasses(class.Name, false); len(subs) > 0 {
+			spans := []docSpan{{Text: "Subclasses: "}}
+			for i, sub := range subs {
+				if i > 0 {
+					spans = append(spans, docSpan{Text: ", "})
+				}
+				spans = append(spans, docSpan{Text: sub.Name, Link: classAnchor(sub.Name)})
+			}
+			w.Paragraph(spans...)
+		}
+		if tags := class.GetTags(); len(tags) > 0 {
+			w.Paragraph(docSpan{Text: "Tags: "}, docTags(tags))
+		}
+		members := class.GetMembers()
+		if len(members) == 0 {
+			continue
+		}
+		rows := make([][][]docSpan, 0, len(members))
+		for _, member := range members {
+			var kind, security string
+			var sig docSpan
+			var typ []docSpan
+			switch member := member.(type) {
+			case *rbxdump.Property:
+				kind = "Property"
+				sig = docSpan{Text: member.Name, Code: true}
+				typ = []docSpan{docType(desc, member.ValueType)}
+				security = docSecurity(member.ReadSecurity, member.WriteSecurity)
+			case *rbxdump.Function:
+				kind = "Function"
+				sig = docSpan{Text: docSignature(member.Name, member.Parameters), Code: true}
+				typ = []docSpan{docType(desc, member.ReturnType)}
+				security = docSecurity(member.Security, member.Security)
+			case *rbxdump.Event:
+				kind = "Event"
+				sig = docSpan{Text: docSignature(member.Name, member.Parameters), Code: true}
+				security = docSecurity(member.Security, member.Security)
+			case *rbxdump.Callback:
+				kind = "Callback"
+				sig = docSpan{Text: docSignature(member.Name, member.Parameters), Code: true}
+				typ = []docSpan{docType(desc, member.ReturnType)}
+				security = docSecurity(member.Security, member.Security)
+			default:
+				continue
+			}
+			rows = append(rows, [][]docSpan{
+				{sig},
+				{{Text: kind}},
+				typ,
+				{{Text: security}},
+				{docTags(member.GetTags())},
+			})
+		}
+		w.Table([]string{"Member", "Kind", "Type", "Security", "Tags"}, rows)
+	}
+
+	for _, enum := range enums {
+		w.Heading(2, enumAnchor(enum.Name), "Enum "+enum.Name)
+		if tags := enum.GetTags(); len(tags) > 0 {
+			w.Paragraph(docSpan{Text: "Tags: "}, docTags(tags))
+		}
+		items := enum.GetEnumItems()
+		if len(items) == 0 {
+			continue
+		}
+		rows := make([][][]docSpan, len(items))
+		for i, item := range items {
+			rows[i] = [][]docSpan{
+				{{Text: item.Name, Code: true}},
+				{{Text: fmt.Sprint(item.Value)}},
+				{docTags(item.GetTags())},
+			}
+		}
+		w.Table([]string{"Item", "Value", "Tags"}, rows)
+	}
+}
+
+// markdownDoc renders a document as Markdown.
+type markdownDoc struct {
+	bytes.Buffer
+}
+
+// spans renders a sequence of spans. If cell is true, then the spans are within
+// a table cell, where "|" must be escaped even within code spans.
+func (w *markdownDoc) spans(spans []docSpan, cell bool) string {
+	var s strings.Builder
+	for _, span := range spans {
+		text := span.Text
+		if text == "" {
+			continue
+		}
+		if span.Code {
+			if cell {
+				text = strings.ReplaceAll(text, "|", "\\|")
+			}
+			text = "`" + text + "`"
+		} else {
+			text = strings.ReplaceAll(text, "|", "\\|")
+		}
+		if span.Link != "" {
+			text = "[" + text + "](#" + span.Link + ")"
+		}
+		s.WriteString(text)
+	}
+	return s.String()
+}
+
+func (w *markdownDoc) block(s string) {
+	if w.Len() > 0 {
+		w.WriteString("\n")
+	}
+	w.WriteString(s)
+}
+
+func (w *markdownDoc) Heading(level int, id, text string) {
+	// Anchors are derived from the heading text.
+	w.block(strings.Repeat("#", level) + " " + text + "\n")
+}
+
+func (w *markdownDoc) Paragraph(spans ...docSpan) {
+	w.block(w.spans(spans, false) + "\n")
+}
+
+func (w *markdownDoc) List(items [][]docSpan) {
+	var s strings.Builder
+	for _, item := range items {
+		s.WriteString("- " + w.spans(item, false) + "\n")
+	}
+	w.block(s.String())
+}
+
+func (w *markdownDoc) Table(header []string, rows [][][]docSpan) {
+	var s strings.Builder
+	s.WriteString(strings.Join(header, " | ") + "\n")
+	for i := range header {
+		if i > 0 {
+			s.WriteString("|")
+		}
+		s.WriteString("---")
+	}
+	s.WriteString("\n")
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = w.spans(cell, true)
+		}
+		s.WriteString(strings.TrimRight(strings.Join(cells, " | "), " ") + "\n")
+	}
+	w.block(s.String())
+}
+
+// htmlDoc renders a document as HTML.
+type htmlDoc struct {
+	bytes.Buffer
+}
+
+func (w *htmlDoc) spans(spans []docSpan) string {
+	var s strings.Builder
+	for _, span := range spans {
+		text := html.EscapeString(span.Text)
+		if text == "" {
+			continue
+		}
+		if span.Code {
+			text = "<code>" + text + "</code>"
+		}
+		if span.Link != "" {
+			text = `<a href="#` + html.EscapeString(span.Link) + `">` + text + "</a>"
+		}
+		s.WriteString(text)
+	}
+	return s.String()
+}
+
+func (w *htmlDoc) Heading(level int, id, text string) {
+	if id == "" {
+		fmt.Fprintf(w, "<h%d>%s</h%d>\n", level, html.EscapeString(text), level)
+		return
+	}
+	fmt.Fprintf(w, "<h%d id=\"%s\">%s</h%d>\n", level, html.EscapeString(id), html.EscapeString(text), level)
+}
+
+func (w *htmlDoc) Paragraph(spans ...docSpan) {
+	w.WriteString("<p>" + w.spans(spans) + "</p>\n")
+}
+
+func (w *htmlDoc) List(items [][]docSpan) {
+	w.WriteString("<ul>\n")
+	for _, item := range items {
+		w.WriteString("<li>" + w.spans(item) + "</li>\n")
+	}
+	w.WriteString("</ul>\n")
+}
+
+func (w *htmlDoc) Table(header []string, rows [][][]docSpan) {
+	w.WriteString("<table>\n<thead>\n<tr>")
+	for _, h := range header {
+		w.WriteString("<th>" + html.EscapeString(h) + "</th>")
+	}
+	w.WriteString("</tr>\n</thead>\n<tbody>\n")
+	for _, row := range rows {
+		w.WriteString("<tr>")
+		for _, cell := range row {
+			w.WriteString("<td>" + w.spans(cell) + "</td>")
+		}
+		w.WriteString("</tr>\n")
+	}
+	w.WriteString("</tbody>\n</table>\n")
+}
+
+func init() { register(DescMD) }
+func DescMD() rbxmk.Format {
+	return rbxmk.Format{
+		Name: "desc.md",
+		Encode: func(f rbxmk.FormatOptions, v types.Value) (b []byte, err error) {
+			desc, ok := v.(*rtypes.RootDesc)
+			if !ok {
+				return nil, cannotEncode(v)
+			}
+			var w markdownDoc
+			encodeDoc(&w, desc)
+			return w.Bytes(), nil
+		},
+	}
+}
+
+func init() { register(DescHTML) }
+func DescHTML() rbxmk.Format {
+	return rbxmk.Format{
+		Name: "desc.html",
+		Encode: func(f rbxmk.FormatOptions, v types.Value) (b []byte, err error) {
+			desc, ok := v.(*rtypes.RootDesc)
+			if !ok {
+				return nil, cannotEncode(v)
+			}
+			var w htmlDoc
+			w.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>API Reference</title>\n</head>\n<body>\n")
+			encodeDoc(&w, desc)
+			w.WriteString("</body>\n</html>\n")
+			return w.Bytes(), nil
+		},
+	}
+}
@@ -0,0 +1,312 @@
+package formats
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/anaminus/rbxmk"
+	"github.com/anaminus/rbxmk/rtypes"
+	"github.com/robloxapi/rbxdump"
+	"github.com/robloxapi/rbxdump/diff"
+	"github.com/robloxapi/types"
+)
+
+// reportGroup contains the actions that apply to a single class or enum.
+type reportGroup struct {
+	Name    string
+	Primary []diff.Action
+	Members []diff.Action
+}
+
+// groupActions groups a list of actions by class and enum. Groups are sorted by
+// name, and the member actions of each group are sorted by member name.
+func groupActions(actions rtypes.DescActions) (classes, enums []*reportGroup) {
+	classMap := map[string]*reportGroup{}
+	enumMap := map[string]*reportGroup{}
+	for _, action := range actions {
+		if action == nil {
+			continue
+		}
+		var groups map[string]*reportGroup
+		var list *[]*reportGroup
+		switch action.Element {
+		case diff.Class, diff.Property, diff.Function, diff.Event, diff.Callback:
+			groups, list = classMap, &classes
+		case diff.Enum, diff.EnumItem:
+			groups, list = enumMap, &enums
+		default:
+			continue
+		}
+		group, ok := groups[action.Primary]
+		if !ok {
+			group = &reportGroup{Name: action.Primary}
+			groups[action.Primary] = group
+			*list = append(*list, group)
+		}
+		switch action.Element {
+		case diff.Class, diff.Enum:
+			group.Primary = append(group.Primary, action.Action)
+		default:
+			group.Members = append(group.Members, action.Action)
+		}
+	}
+	for _, list := range [][]*reportGroup{classes, enums} {
+		sort.Slice(list, func(i, j int) bool {
+			return list[i].Name < list[j].Name
+		})
+		for _, group := range list {
+			sort.SliceStable(group.Members, func(i, j int) bool {
+				return group.Members[i].Secondary < group.Members[j].Secondary
+			})
+		}
+	}
+	return classes, enums
+}
+
+// reportVerb returns a past-tense verb describing an action type.
+func reportVerb(t diff.Type) string {
+	switch t {
+	case diff.Add:
+		return "Added"
+	case diff.Remove:
+		return "Removed"
+	case diff.Change:
+		return "Changed"
+	}
+	return t.String()
+}
+
+// reportElement returns a lowercase name of an element type.
+func reportElement(e diff.Element) string {
+	if e == diff.EnumItem {
+		return "item"
+	}
+	return strings.ToLower(e.String())
+}
+
+// reportValue formats the value of a field.
+func reportValue(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case rbxdump.Type:
+		return v.Name
+	case rbxdump.Tags:
+		return "[" + strings.Join(v, ", ") + "]"
+	case []string:
+		return "[" + strings.Join(v, ", ") + "]"
+	case []rbxdump.Parameter:
+		return reportParams(v)
+	case map[string]interface{}:
+		// Type or parameter decoded from JSON.
+		if name, ok := v["Name"].(string); ok {
+			if t, ok := v["Type"]; ok {
+				return name + ": " + reportValue(t)
+			}
+			return name
+		}
+	case []interface{}:
+		// Tags or parameters decoded from JSON.
+		s := make([]string, len(v))
+		params := false
+		for i, e := range v {
+			if _, ok := e.(map[string]interface{}); ok {
+				params = true
+			}
+			s[i] = reportValue(e)
+		}
+		if params {
+			return "(" + strings.Join(s, ", ") + ")"
+		}
+		return "[" + strings.Join(s, ", ") + "]"
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// reportParams formats a list of parameters.
+func reportParams(params []rbxdump.Parameter) string {
+	s := make([]string, len(params))
+	for i, param := range params {
+		s[i] = param.Name + ": " + param.Type.Name
+		if param.Optional {
+			s[i] += " = " + param.Default
+		}
+	}
+	return "(" + strings.Join(s, ", ") + ")"
+}
+
+// reportSignature formats the name of the element of an action, along with
+// type information found in the fields of the action.
+func reportSignature(a diff.Action) string {
+	name := a.Secondary
+	if a.Element == diff.Class || a.Element == diff.Enum {
+		name = a.Primary
+	}
+	if a.Type != diff.Add {
+		return name
+	}
+	switch a.Element {
+	case diff.Property:
+		if v, ok := a.Fields["ValueType"]; ok {
+			name += ": " + reportValue(v)
+		}
+	case diff.Function, diff.Event, diff.Callback:
+		if v, ok := a.Fields["Parameters"]; ok {
+			name += reportValue(v)
+		} else {
+			name += "()"
+		}
+		if v, ok := a.Fields["ReturnType"]; ok {
+			name += ": " + reportValue(v)
+		}
+	case diff.Class:
+		if v, ok := a.Fields["Superclass"].(string); ok && v != "" {
+			name += " : " + v
+		}
+	case diff.EnumItem:
+		if v, ok := a.Fields["Value"]; ok {
+			name += " = " + reportValue(v)
+		}
+	}
+	return name
+}
+
+// reportDetails formats the fields of an action that are not included by
+// reportSignature. For an Add action, only security and tags are included. For
+// a Change action, every field is included.
+func reportDetails(a diff.Action) string {
+	var names []string
+	switch a.Type {
+	case diff.Add:
+		for _, name := range []string{"Security", "ReadSecurity", "WriteSecurity"} {
+			if v, ok := a.Fields[name].(string); ok && v != "None" && v != "" {
+				names = append(names, name)
+			}
+		}
+		// Tags decoded from JSON are not rbxdump.Tags.
+		switch v := a.Fields["Tags"].(type) {
+		case rbxdump.Tags:
+			if len(v) > 0 {
+				names = append(names, "Tags")
+			}
+		case []string:
+			if len(v) > 0 {
+				names = append(names, "Tags")
+			}
+		case []interface{}:
+			if len(v) > 0 {
+				names = append(names, "Tags")
+			}
+		}
+	case diff.Change:
+		for name := range a.Fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+	details := make([]string, len(names))
+	for i, name := range names {
+		details[i] = name + ": " + reportValue(a.Fields[name])
+	}
+	return strings.Join(details, "; ")
+}
+
+// encodeReport writes a report of actions, which may be DescActions or an Array
+// of DescAction values. header is called for each section and group, and line
+// is called for each action with the signature and details of the action.
+func encodeReport(v types.Value, header func(w *bytes.Buffer, level int, text string), line func(w *bytes.Buffer, a diff.Action, sig, details string)) (b []byte, err error) {
+	var actions rtypes.DescActions
+	switch v := v.(type) {
+	case rtypes.DescActions:
+		actions = v
+	case rtypes.Array:
+		actions = make(rtypes.DescActions, len(v))
+		for i, a := range v {
+			if actions[i], _ = a.(*rtypes.DescAction); actions[i] == nil {
+				return nil, cannotEncode(v)
+			}
+		}
+	case rtypes.Dictionary:
+		// An empty table is received as an empty dictionary.
+		if len(v) > 0 {
+			return nil, cannotEncode(v)
+		}
+	default:
+		return nil, cannotEncode(v)
+	}
+	classes, enums := groupActions(actions)
+	var buf bytes.Buffer
+	for _, section := range []struct {
+		name   string
+		groups []*reportGroup
+	}{{"Classes", classes}, {"Enums", enums}} {
+		if len(section.groups) == 0 {
+			continue
+		}
+		header(&buf, 1, section.name)
+		for _, group := range section.groups {
+			header(&buf, 2, group.Name)
+			for _, a := range group.Primary {
+				line(&buf, a, reportSignature(a), reportDetails(a))
+			}
+			for _, a := range group.Members {
+				line(&buf, a, reportSignature(a), reportDetails(a))
+			}
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func init() { register(DescPatchMD) }
+func DescPatchMD() rbxmk.Format {
+	return rbxmk.Format{
+		Name: "desc-patch.md",
+		Encode: func(f rbxmk.FormatOptions, v types.Value) (b []byte, err error) {
+			return encodeReport(v,
+				func(w *bytes.Buffer, level int, text string) {
+					if w.Len() > 0 && !bytes.HasSuffix(w.Bytes(), []byte("\n\n")) {
+						w.WriteString("\n")
+					}
+					w.WriteString(strings.Repeat("#", level) + " " + text + "\n\n")
+				},
+				func(w *bytes.Buffer, a diff.Action, sig, details string) {
+					fmt.Fprintf(w, "- %s %s `%s`", reportVerb(a.Type), reportElement(a.Element), sig)
+					if details != "" {
+						fmt.Fprintf(w, " (%s)", details)
+					}
+					w.WriteString("\n")
+				},
+			)
+		},
+	}
+}
+
+func init() { register(DescPatchTXT) }
+func DescPatchTXT() rbxmk.Format {
+	return rbxmk.Format{
+		Name: "desc-patch.txt",
+		Encode: func(f rbxmk.FormatOptions, v types.Value) (b []byte, err error) {
+			return encodeReport(v,
+				func(w *bytes.Buffer, level int, text string) {
+					if level == 1 {
+						if w.Len() > 0 {
+							w.WriteString("\n")
+						}
+						w.WriteString(text + "\n")
+						return
+					}
+					w.WriteString("\t" + text + "\n")
+				},
+				func(w *bytes.Buffer, a diff.Action, sig, details string) {
+					fmt.Fprintf(w, "\t\t%s %s %s", reportVerb(a.Type), reportElement(a.Element), sig)
+					if details != "" {
+						fmt.Fprintf(w, " [%s]", details)
+					}
+					w.WriteString("\n")
+				},
+			)
+		},
+	}
+}
@@ -0,0 +1,281 @@
+package formats
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	"github.com/anaminus/rbxmk/rtypes"
+	"github.com/robloxapi/rbxdump"
+	"github.com/robloxapi/rbxdump/legacy"
+)
+
+// legacySecurity contains the security contexts that appear as tags in the
+// legacy dump format.
+var legacySecurity = map[string]bool{
+	"LocalUserSecurity":     true,
+	"PluginSecurity":        true,
+	"RobloxPlaceSecurity":   true,
+	"RobloxScriptSecurity":  true,
+	"RobloxSecurity":        true,
+	"WritePlayerSecurity":   true,
+	"NotAccessibleSecurity": true,
+}
+
+// legacyWriteSecurity is the prefix of a tag indicating the write security of a
+// property, when it differs from the read security.
+const legacyWriteSecurity = "ScriptWriteRestricted: "
+
+// legacyCategories maps the names of non-class, non-enum types to their
+// category.
+var legacyCategories = map[string]string{
+	"bool":       "Primitive",
+	"int":        "Primitive",
+	"int64":      "Primitive",
+	"float":      "Primitive",
+	"double":     "Primitive",
+	"string":     "Primitive",
+	"void":       "Primitive",
+	"null":       "Primitive",
+	"Array":      "Group",
+	"Dictionary": "Group",
+	"Map":        "Group",
+	"Tuple":      "Group",
+	"Variant":    "Group",
+}
+
+// isLegacyName returns whether s can be decoded as a name in the legacy format.
+func isLegacyName(s string, spaces bool) bool {
+	if s == "" || s[0] == ' ' || s[len(s)-1] == ' ' {
+		return false
+	}
+	for _, c := range []byte(s) {
+		switch {
+		case c == '_', '0' <= c && c <= '9', 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z':
+		case spaces && (c == ' ' || c == '<' || c == '>'):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// legacyType strips the category from a type.
+func legacyType(t rbxdump.Type) rbxdump.Type {
+	return rbxdump.Type{Name: t.Name}
+}
+
+// legacyParams strips the category from the type of each parameter. A default
+// value that cannot be represented is replaced with an empty value. Returns
+// false if a parameter name cannot be represented.
+func legacyParams(params []rbxdump.Parameter) ([]rbxdump.Parameter, bool) {
+	p := make([]rbxdump.Parameter, len(params))
+	for i, param := range params {
+		if !isLegacyName(param.Name, false) {
+			return nil, false
+		}
+		param.Type = legacyType(param.Type)
+		if strings.ContainsAny(param.Default, ",)") {
+			param.Default = ""
+		}
+		p[i] = param
+	}
+	return p, true
+}
+
+// legacyTags returns tags with a tag for each security context that is not
+// None.
+func legacyTags(tags rbxdump.Tags, read, write string) rbxdump.Tags {
+	tags = append(rbxdump.Tags{}, tags...)
+	if read != "None" && read != "" {
+		tags.SetTag(read)
+	}
+	if write != read && write != "" {
+		tags.SetTag(legacyWriteSecurity + "[" + write + "]")
+	}
+	return tags
+}
+
+// legacyMember returns member converted to the form encoded by the legacy
+// format. Returns nil if the member cannot be represented.
+func legacyMember(member rbxdump.Member) rbxdump.Member {
+	if !isLegacyName(member.MemberName(), true) {
+		return nil
+	}
+	switch m := member.(type) {
+	case *rbxdump.Property:
+		return &rbxdump.Property{
+			Name:      m.Name,
+			ValueType: legacyType(m.ValueType),
+			Tags:      legacyTags(m.Tags, m.ReadSecurity, m.WriteSecurity),
+		}
+	case *rbxdump.Function:
+		params, ok := legacyParams(m.Parameters)
+		if !ok {
+			return nil
+		}
+		return &rbxdump.Function{
+			Name:       m.Name,
+			Parameters: params,
+			ReturnType: legacyType(m.ReturnType),
+			Tags:       legacyTags(m.Tags, m.Security, m.Security),
+		}
+	case *rbxdump.Event:
+		params, ok := legacyParams(m.Parameters)
+		if !ok {
+			return nil
+		}
+		return &rbxdump.Event{
+			Name:       m.Name,
+			Parameters: params,
+			Tags:       legacyTags(m.Tags, m.Security, m.Security),
+		}
+	case *rbxdump.Callback:
+		params, ok := legacyParams(m.Parameters)
+		if !ok {
+			return nil
+		}
+		return &rbxdump.Callback{
+			Name:       m.Name,
+			Parameters: params,
+			ReturnType: legacyType(m.ReturnType),
+			Tags:       legacyTags(m.Tags, m.Security, m.Security),
+		}
+	}
+	return nil
+}
+
+// encodeLegacyBlock encodes root, which contains a single class or enum, to
+// buf. The lines following the first line are sorted.
+func encodeLegacyBlock(buf *bytes.Buffer, root *rbxdump.Root) error {
+	var block bytes.Buffer
+	if err := legacy.Encode(&block, root); err != nil {
+		return err
+	}
+	lines := strings.Split(strings.TrimSuffix(block.String(), "\n"), "\n")
+	sort.Strings(lines[1:])
+	for _, line := range lines {
+		buf.WriteString(line)
+		buf.WriteString("\n")
+	}
+	return nil
+}
+
+// encodeLegacy encodes desc in the legacy dump format. Classes are written
+// after their superclasses, and members and items are sorted. Members and items
+// with names that cannot be represented in the format are omitted.
+func encodeLegacy(desc *rtypes.RootDesc) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, class := range sortClasses(desc) {
+		c := &rbxdump.Class{
+			Name:       class.Name,
+			Superclass: class.Superclass,
+			Members:    make(map[string]rbxdump.Member, len(class.Members)),
+			Tags:       class.Tags,
+		}
+		for name, member := range class.Members {
+			if member = legacyMember(member); member != nil {
+				c.Members[name] = member
+			}
+		}
+		root := &rbxdump.Root{Classes: map[string]*rbxdump.Class{c.Name: c}}
+		if err := encodeLegacyBlock(&buf, root); err != nil {
+			return nil, err
+		}
+	}
+	for _, enum := range desc.GetEnums() {
+		e := &rbxdump.Enum{
+			Name:  enum.Name,
+			Items: make(map[string]*rbxdump.EnumItem, len(enum.Items)),
+			Tags:  enum.Tags,
+		}
+		for name, item := range enum.Items {
+			if isLegacyName(name, true) {
+				e.Items[name] = item
+			}
+		}
+		root := &rbxdump.Root{Enums: map[string]*rbxdump.Enum{e.Name: e}}
+		if err := encodeLegacyBlock(&buf, root); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// resolveLegacyType sets the category of a type decoded from the legacy format.
+// A name that refers to both an enum and a class is resolved as an enum.
+func resolveLegacyType(root *rbxdump.Root, t *rbxdump.Type) {
+	if i := strings.Index(t.Name, ":"); i >= 0 {
+		t.Category, t.Name = t.Name[:i], t.Name[i+1:]
+		return
+	}
+	switch {
+	case legacyCategories[t.Name] != "":
+		t.Category = legacyCategories[t.Name]
+	case root.Enums[t.Name] != nil:
+		t.Category = "Enum"
+	case root.Classes[t.Name] != nil:
+		t.Category = "Class"
+	default:
+		t.Category = "DataType"
+	}
+}
+
+// resolveLegacySecurity removes security tags from tags, returning the read and
+// write security indicated by the tags.
+func resolveLegacySecurity(tags *rbxdump.Tags) (read, write string) {
+	read = "None"
+	for _, tag := range tags.GetTags() {
+		switch {
+		case legacySecurity[tag]:
+			read = tag
+			tags.UnsetTag(tag)
+		case strings.HasPrefix(tag, legacyWriteSecurity):
+			write = strings.TrimSuffix(strings.TrimPrefix(tag[len(legacyWriteSecurity):], "["), "]")
+			tags.UnsetTag(tag)
+		}
+	}
+	if write == "" {
+		write = read
+	}
+	return read, write
+}
+
+// decodeLegacy decodes a root descriptor from the legacy dump format. Type
+// categories are resolved from the names of types, and security contexts are
+// resolved from tags. Properties are assumed to be loadable and saveable,
+// since the format does not include these fields.
+func decodeLegacy(b []byte) (*rtypes.RootDesc, error) {
+	root, err := legacy.Decode(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	for _, class := range root.Classes {
+		for _, member := range class.Members {
+			switch m := member.(type) {
+			case *rbxdump.Property:
+				resolveLegacyType(root, &m.ValueType)
+				m.ReadSecurity, m.WriteSecurity = resolveLegacySecurity(&m.Tags)
+				m.CanLoad, m.CanSave = true, true
+			case *rbxdump.Function:
+				resolveLegacyType(root, &m.ReturnType)
+				for i := range m.Parameters {
+					resolveLegacyType(root, &m.Parameters[i].Type)
+				}
+				m.Security, _ = resolveLegacySecurity(&m.Tags)
+			case *rbxdump.Event:
+				for i := range m.Parameters {
+					resolveLegacyType(root, &m.Parameters[i].Type)
+				}
+				m.Security, _ = resolveLegacySecurity(&m.Tags)
+			case *rbxdump.Callback:
+				resolveLegacyType(root, &m.ReturnType)
+				for i := range m.Parameters {
+					resolveLegacyType(root, &m.Parameters[i].Type)
+				}
+				m.Security, _ = resolveLegacySecurity(&m.Tags)
+			}
+		}
+	}
+	return &rtypes.RootDesc{Root: root}, nil
+}
@@ -0,0 +1,141 @@
+package formats
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/anaminus/rbxmk"
+	"github.com/anaminus/rbxmk/rtypes"
+	"github.com/robloxapi/rbxdump"
+	"github.com/robloxapi/types"
+)
+
+// luauKeywords contains reserved words that cannot be used as identifiers in
+// Luau.
+var luauKeywords = map[string]bool{
+	"and": true, "break": true, "do": true, "else": true, "elseif": true,
+	"end": true, "false": true, "for": true, "function": true, "if": true,
+	"in": true, "local": true, "nil": true, "not": true, "or": true,
+	"repeat": true, "return": true, "then": true, "true": true, "until": true,
+	"while": true,
+}
+
+// luauTypes maps descriptor types to Luau types.
+var luauTypes = typeMap{
+	Kinds: map[typeKind]string{
+		kindAny:        "any",
+		kindVoid:       "()",
+		kindBool:       "boolean",
+		kindNumber:     "number",
+		kindString:     "string",
+		kindFunction:   "(...any) -> ...any",
+		kindArray:      "{any}",
+		kindDictionary: "{[string]: any}",
+		kindMap:        "{[any]: any}",
+		kindTuple:      "...any",
+		kindObjects:    "{Instance}",
+	},
+	Class: func(name string) string { return name + "?" },
+	Enum:  func(name string) string { return "Enum" + name },
+	Data:  func(name string) string { return name },
+}
+
+// luauParams formats a list of parameters. If self is true, then a self
+// parameter is included first.
+func luauParams(params []rbxdump.Parameter, self bool) string {
+	s := make([]string, 0, len(params)+1)
+	if self {
+		s = append(s, "self")
+	}
+	for i, param := range params {
+		if resolveType(param.Type) == kindTuple {
+			s = append(s, "...: any")
+			continue
+		}
+		name := param.Name
+		if !isIdent(name, luauKeywords) {
+			name = fmt.Sprintf("arg%d", i+1)
+		}
+		typ := luauTypes.Type(param.Type)
+		if param.Optional && !strings.HasSuffix(typ, "?") && typ != "any" {
+			if strings.Contains(typ, "->") {
+				// Parenthesize so that "?" applies to the whole type.
+				typ = "(" + typ + ")"
+			}
+			typ += "?"
+		}
+		s = append(s, name+": "+typ)
+	}
+	return "(" + strings.Join(s, ", ") + ")"
+}
+
+// encodeLuau writes declarations for the classes and enums of desc.
+func encodeLuau(desc *rtypes.RootDesc) []byte {
+	var buf bytes.Buffer
+	for _, class := range sortClasses(desc) {
+		if !isIdent(class.Name, luauKeywords) {
+			continue
+		}
+		fmt.Fprintf(&buf, "declare class %s", class.Name)
+		if desc.Classes[class.Superclass] != nil {
+			fmt.Fprintf(&buf, " extends %s", class.Superclass)
+		}
+		buf.WriteString("\n")
+		for _, member := range class.GetMembers() {
+			if !isIdent(member.MemberName(), luauKeywords) {
+				continue
+			}
+			switch member := member.(type) {
+			case *rbxdump.Property:
+				fmt.Fprintf(&buf, "\t%s: %s\n", member.Name, luauTypes.Type(member.ValueType))
+			case *rbxdump.Function:
+				fmt.Fprintf(&buf, "\tfunction %s%s: %s\n", member.Name, luauParams(member.Parameters, true), luauTypes.Type(member.ReturnType))
+			case *rbxdump.Event:
+				fmt.Fprintf(&buf, "\t%s: RBXScriptSignal\n", member.Name)
+			case *rbxdump.Callback:
+				fmt.Fprintf(&buf, "\t%s: %s -> %s\n", member.Name, luauParams(member.Parameters, false), luauTypes.Type(member.ReturnType))
+			}
+		}
+		buf.WriteString("end\n\n")
+	}
+
+	var enums []*rbxdump.Enum
+	for _, enum := range desc.GetEnums() {
+		if isIdent(enum.Name, luauKeywords) {
+			enums = append(enums, enum)
+		}
+	}
+	for _, enum := range enums {
+		fmt.Fprintf(&buf, "declare class Enum%s extends EnumItem\nend\n", enum.Name)
+		fmt.Fprintf(&buf, "declare class Enum%s_INTERNAL extends Enum\n", enum.Name)
+		for _, item := range enum.GetEnumItems() {
+			if isIdent(item.Name, luauKeywords) {
+				fmt.Fprintf(&buf, "\t%s: Enum%s\n", item.Name, enum.Name)
+			}
+		}
+		buf.WriteString("end\n\n")
+	}
+	if len(enums) > 0 {
+		buf.WriteString("type ENUM_LIST = {\n")
+		for _, enum := range enums {
+			fmt.Fprintf(&buf, "\t%s: Enum%s_INTERNAL,\n", enum.Name, enum.Name)
+		}
+		buf.WriteString("}\n\ndeclare Enum: ENUM_LIST\n")
+	}
+	return buf.Bytes()
+}
+
+func init() { register(DLuau) }
+func DLuau() rbxmk.Format {
+	return rbxmk.Format{
+		Name: "d.luau",
+		Encode: func(f rbxmk.FormatOptions, v types.Value) (b []byte, err error) {
+			desc, ok := v.(*rtypes.RootDesc)
+			if !ok {
+				return nil, cannotEncode(v)
+			}
+			return encodeLuau(desc), nil
+		},
+	}
+}
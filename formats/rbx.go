@@ -2,6 +2,7 @@ package formats
 
 import (
 	"bytes"
+	"errors"
 	"io"
 
 	"github.com/anaminus/rbxmk"
@@ -269,7 +270,9 @@ func encodeValue(t types.PropValue, refs encinst, prefs *[]encprop) (r rbxfile.V
 	}
 }
 
-func encodeInstance(t *rtypes.Instance, refs encinst, prefs *[]encprop) (r *rbxfile.Instance, err error) {
+// encodeInstance encodes t and its descendants. If desc is not nil, then each
+// property is normalized against it before being encoded.
+func encodeInstance(t *rtypes.Instance, desc *rtypes.RootDesc, refs encinst, prefs *[]encprop) (r *rbxfile.Instance, err error) {
 	if r, ok := refs[t]; ok {
 		return r, nil
 	}
@@ -278,6 +281,11 @@ func encodeInstance(t *rtypes.Instance, refs encinst, prefs *[]encprop) (r *rbxf
 	r.Reference = t.Reference
 	refs[t] = r
 	for prop, value := range t.Properties() {
+		if desc != nil {
+			if value = desc.NormalizeValue(t.ClassName, prop, value); value == nil {
+				continue
+			}
+		}
 		if v, ok := value.(*rtypes.Instance); ok {
 			*prefs = append(*prefs, encprop{
 				Instance: r,
@@ -293,7 +301,7 @@ func encodeInstance(t *rtypes.Instance, refs encinst, prefs *[]encprop) (r *rbxf
 		r.Properties[prop] = v
 	}
 	for _, tc := range t.Children() {
-		rc, err := encodeInstance(tc, refs, prefs)
+		rc, err := encodeInstance(tc, desc, refs, prefs)
 		if err != nil {
 			return nil, err
 		}
@@ -302,7 +310,7 @@ func encodeInstance(t *rtypes.Instance, refs encinst, prefs *[]encprop) (r *rbxf
 	return r, nil
 }
 
-func encodeDataModel(t *rtypes.Instance) (r *rbxfile.Root, err error) {
+func encodeDataModel(t *rtypes.Instance, desc *rtypes.RootDesc) (r *rbxfile.Root, err error) {
 	r = rbxfile.NewRoot()
 	for prop, value := range t.Properties() {
 		if s := (rtypes.Stringlike{Value: value}); s.IsStringlike() {
@@ -312,7 +320,7 @@ func encodeDataModel(t *rtypes.Instance) (r *rbxfile.Root, err error) {
 	refs := encinst{}
 	prefs := []encprop{}
 	for _, tc := range t.Children() {
-		rc, err := encodeInstance(tc, refs, &prefs)
+		rc, err := encodeInstance(tc, desc, refs, &prefs)
 		if err != nil {
 			return nil, err
 		}
@@ -326,15 +334,28 @@ func encodeDataModel(t *rtypes.Instance) (r *rbxfile.Root, err error) {
 	return
 }
 
-func decodeRBX(method func(r io.Reader) (root *rbxfile.Root, err error), b []byte) (v types.Value, err error) {
+func decodeRBX(method func(r io.Reader) (root *rbxfile.Root, err error), f rbxmk.FormatOptions, b []byte) (v types.Value, err error) {
 	root, err := method(bytes.NewReader(b))
 	if err != nil {
 		return nil, err
 	}
-	return decodeDataModel(root)
+	t, err := decodeDataModel(root)
+	if err != nil {
+		return nil, err
+	}
+	if f.Coerce {
+		if f.Desc == nil {
+			return nil, errors.New("cannot coerce: no descriptor")
+		}
+		problems := f.Desc.Coerce(t)
+		if f.Problems != nil {
+			*f.Problems = append(*f.Problems, problems...)
+		}
+	}
+	return t, nil
 }
 
-func encodeRBX(method func(w io.Writer, root *rbxfile.Root) (err error), v types.Value) (b []byte, err error) {
+func encodeRBX(method func(w io.Writer, root *rbxfile.Root) (err error), f rbxmk.FormatOptions, v types.Value) (b []byte, err error) {
 	var t *rtypes.Instance
 	switch v := v.(type) {
 	case *rtypes.Instance:
@@ -352,7 +373,11 @@ func encodeRBX(method func(w io.Writer, root *rbxfile.Root) (err error), v types
 	default:
 		return nil, cannotEncode(v)
 	}
-	r, err := encodeDataModel(t)
+	var desc *rtypes.RootDesc
+	if f.Normalize {
+		desc = f.Desc
+	}
+	r, err := encodeDataModel(t, desc)
 	if err != nil {
 		return nil, err
 	}
@@ -368,10 +393,10 @@ func RBXL() rbxmk.Format {
 	return rbxmk.Format{
 		Name: "rbxl",
 		Decode: func(f rbxmk.FormatOptions, b []byte) (v types.Value, err error) {
-			return decodeRBX(rbxl.DeserializePlace, b)
+			return decodeRBX(rbxl.DeserializePlace, f, b)
 		},
 		Encode: func(f rbxmk.FormatOptions, v types.Value) (b []byte, err error) {
-			return encodeRBX(rbxl.SerializePlace, v)
+			return encodeRBX(rbxl.SerializePlace, f, v)
 		},
 	}
 }
@@ -381,10 +406,10 @@ func RBXM() rbxmk.Format {
 	return rbxmk.Format{
 		Name: "rbxm",
 		Decode: func(f rbxmk.FormatOptions, b []byte) (v types.Value, err error) {
-			return decodeRBX(rbxl.DeserializeModel, b)
+			return decodeRBX(rbxl.DeserializeModel, f, b)
 		},
 		Encode: func(f rbxmk.FormatOptions, v types.Value) (b []byte, err error) {
-			return encodeRBX(rbxl.SerializeModel, v)
+			return encodeRBX(rbxl.SerializeModel, f, v)
 		},
 	}
 }
@@ -394,10 +419,10 @@ func RBXLX() rbxmk.Format {
 	return rbxmk.Format{
 		Name: "rbxlx",
 		Decode: func(f rbxmk.FormatOptions, b []byte) (v types.Value, err error) {
-			return decodeRBX(rbxlx.Deserialize, b)
+			return decodeRBX(rbxlx.Deserialize, f, b)
 		},
 		Encode: func(f rbxmk.FormatOptions, v types.Value) (b []byte, err error) {
-			return encodeRBX(rbxlx.Serialize, v)
+			return encodeRBX(rbxlx.Serialize, f, v)
 		},
 	}
 }
@@ -407,10 +432,10 @@ func RBXMX() rbxmk.Format {
 	return rbxmk.Format{
 		Name: "rbxmx",
 		Decode: func(f rbxmk.FormatOptions, b []byte) (v types.Value, err error) {
-			return decodeRBX(rbxlx.Deserialize, b)
+			return decodeRBX(rbxlx.Deserialize, f, b)
 		},
 		Encode: func(f rbxmk.FormatOptions, v types.Value) (b []byte, err error) {
-			return encodeRBX(rbxlx.Serialize, v)
+			return encodeRBX(rbxlx.Serialize, f, v)
 		},
 	}
 }
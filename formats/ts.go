@@ -0,0 +1,166 @@
+package formats
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/anaminus/rbxmk"
+	"github.com/anaminus/rbxmk/rtypes"
+	"github.com/robloxapi/rbxdump"
+	"github.com/robloxapi/types"
+)
+
+// tsKeywords contains reserved words that cannot be used as identifiers in
+// TypeScript.
+var tsKeywords = map[string]bool{
+	"break": true, "case": true, "catch": true, "class": true, "const": true,
+	"continue": true, "debugger": true, "default": true, "delete": true,
+	"do": true, "else": true, "enum": true, "export": true, "extends": true,
+	"false": true, "finally": true, "for": true, "function": true, "if": true,
+	"import": true, "in": true, "instanceof": true, "new": true, "null": true,
+	"return": true, "super": true, "switch": true, "this": true, "throw": true,
+	"true": true, "try": true, "typeof": true, "var": true, "void": true,
+	"while": true, "with": true,
+}
+
+// tsTypes maps descriptor types to TypeScript types.
+var tsTypes = typeMap{
+	Kinds: map[typeKind]string{
+		kindAny:        "unknown",
+		kindVoid:       "void",
+		kindBool:       "boolean",
+		kindNumber:     "number",
+		kindString:     "string",
+		kindFunction:   "Callback",
+		kindArray:      "Array<unknown>",
+		kindDictionary: "{ [key: string]: unknown }",
+		kindMap:        "Map<unknown, unknown>",
+		kindTuple:      "LuaTuple<Array<unknown>>",
+		kindObjects:    "Array<Instance>",
+	},
+	Class: func(name string) string { return name + " | undefined" },
+	Enum:  func(name string) string { return "Enum." + name },
+	Data:  func(name string) string { return name },
+}
+
+// tsName returns name as a property name, quoting it if it is not a valid
+// identifier.
+func tsName(name string) string {
+	if isIdent(name, nil) {
+		return name
+	}
+	return strconv.Quote(name)
+}
+
+// tsParams formats a list of parameters. If this is not empty, then a this
+// parameter of the given type is included first. An optional parameter is
+// marked optional only if each following parameter is also optional.
+func tsParams(params []rbxdump.Parameter, this string) string {
+	s := make([]string, 0, len(params)+1)
+	if this != "" {
+		s = append(s, "this: "+this)
+	}
+	optional := true
+	marks := make([]bool, len(params))
+	for i := len(params) - 1; i >= 0; i-- {
+		optional = optional && params[i].Optional
+		marks[i] = optional
+	}
+	for i, param := range params {
+		if resolveType(param.Type) == kindTuple {
+			s = append(s, "...args: Array<unknown>")
+			continue
+		}
+		name := param.Name
+		if !isIdent(name, tsKeywords) {
+			name = fmt.Sprintf("arg%d", i+1)
+		}
+		typ := tsTypes.Type(param.Type)
+		switch {
+		case marks[i]:
+			name += "?"
+		case param.Optional && typ != "unknown" && !strings.HasSuffix(typ, " | undefined"):
+			typ += " | undefined"
+		}
+		s = append(s, name+": "+typ)
+	}
+	return "(" + strings.Join(s, ", ") + ")"
+}
+
+// encodeTS writes declarations for the classes and enums of desc.
+func encodeTS(desc *rtypes.RootDesc) []byte {
+	var buf bytes.Buffer
+	for _, class := range sortClasses(desc) {
+		if !isIdent(class.Name, tsKeywords) {
+			continue
+		}
+		fmt.Fprintf(&buf, "interface %s", class.Name)
+		if desc.Classes[class.Superclass] != nil {
+			fmt.Fprintf(&buf, " extends %s", class.Superclass)
+		}
+		buf.WriteString(" {\n")
+		for _, member := range class.GetMembers() {
+			switch member := member.(type) {
+			case *rbxdump.Property:
+				fmt.Fprintf(&buf, "\t%s: %s;\n", tsName(member.Name), tsTypes.Type(member.ValueType))
+			case *rbxdump.Function:
+				fmt.Fprintf(&buf, "\t%s%s: %s;\n", tsName(member.Name), tsParams(member.Parameters, class.Name), tsTypes.Type(member.ReturnType))
+			case *rbxdump.Event:
+				fmt.Fprintf(&buf, "\treadonly %s: RBXScriptSignal;\n", tsName(member.Name))
+			case *rbxdump.Callback:
+				fmt.Fprintf(&buf, "\t%s: %s => %s;\n", tsName(member.Name), tsParams(member.Parameters, ""), tsTypes.Type(member.ReturnType))
+			}
+		}
+		buf.WriteString("}\n\n")
+	}
+
+	var enums []*rbxdump.Enum
+	for _, enum := range desc.GetEnums() {
+		if isIdent(enum.Name, tsKeywords) {
+			enums = append(enums, enum)
+		}
+	}
+	if len(enums) == 0 {
+		return buf.Bytes()
+	}
+	buf.WriteString("declare namespace Enum {\n")
+	for _, enum := range enums {
+		var names []string
+		fmt.Fprintf(&buf, "\tnamespace %s {\n", enum.Name)
+		for _, item := range enum.GetEnumItems() {
+			if !isIdent(item.Name, tsKeywords) {
+				continue
+			}
+			names = append(names, enum.Name+"."+item.Name)
+			fmt.Fprintf(&buf, "\t\tinterface %s extends EnumItem {\n", item.Name)
+			fmt.Fprintf(&buf, "\t\t\tName: %q;\n", item.Name)
+			fmt.Fprintf(&buf, "\t\t\tValue: %d;\n", item.Value)
+			buf.WriteString("\t\t}\n")
+			fmt.Fprintf(&buf, "\t\tconst %s: %s;\n", item.Name, item.Name)
+		}
+		buf.WriteString("\t}\n")
+		if len(names) == 0 {
+			fmt.Fprintf(&buf, "\ttype %s = never;\n", enum.Name)
+		} else {
+			fmt.Fprintf(&buf, "\ttype %s = %s;\n", enum.Name, strings.Join(names, " | "))
+		}
+	}
+	buf.WriteString("}\n")
+	return buf.Bytes()
+}
+
+func init() { register(DTS) }
+func DTS() rbxmk.Format {
+	return rbxmk.Format{
+		Name: "d.ts",
+		Encode: func(f rbxmk.FormatOptions, v types.Value) (b []byte, err error) {
+			desc, ok := v.(*rtypes.RootDesc)
+			if !ok {
+				return nil, cannotEncode(v)
+			}
+			return encodeTS(desc), nil
+		},
+	}
+}
@@ -0,0 +1,174 @@
+package rbxmk
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	lua "github.com/yuin/gopher-lua"
+)
+
+// FS is a filesystem through which files are read and written.
+type FS interface {
+	// ReadFile returns the content of the file at name.
+	ReadFile(name string) ([]byte, error)
+	// WriteFile writes data to the file at name, creating it if necessary.
+	WriteFile(name string, data []byte, perm os.FileMode) error
+	// ReadDir returns a list of files in the directory at name, sorted by
+	// name.
+	ReadDir(name string) ([]os.FileInfo, error)
+	// Stat returns information about the file at name.
+	Stat(name string) (os.FileInfo, error)
+}
+
+// CreateFileInfoTable returns a table containing the Name, IsDir, Size, and
+// ModTime fields of info.
+func (s State) CreateFileInfoTable(info os.FileInfo) *lua.LTable {
+	tinfo := s.L.CreateTable(0, 4)
+	tinfo.RawSetString("Name", lua.LString(info.Name()))
+	tinfo.RawSetString("IsDir", lua.LBool(info.IsDir()))
+	tinfo.RawSetString("Size", lua.LNumber(info.Size()))
+	tinfo.RawSetString("ModTime", lua.LNumber(info.ModTime().Unix()))
+	return tinfo
+}
+
+// osFS implements FS with the filesystem of the operating system.
+type osFS struct{}
+
+func (osFS) ReadFile(name string) ([]byte, error) { return ioutil.ReadFile(name) }
+func (osFS) WriteFile(name string, data []byte, perm os.FileMode) error {
+	return ioutil.WriteFile(name, data, perm)
+}
+func (osFS) ReadDir(name string) ([]os.FileInfo, error) { return ioutil.ReadDir(name) }
+func (osFS) Stat(name string) (os.FileInfo, error)      { return os.Stat(name) }
+
+// memEntry is a file or directory within a MemFS.
+type memEntry struct {
+	data    []byte
+	mode    os.FileMode
+	modTime time.Time
+}
+
+// memFileInfo implements os.FileInfo for a MemFS entry.
+type memFileInfo struct {
+	name  string
+	entry memEntry
+}
+
+func (i memFileInfo) Name() string       { return i.name }
+func (i memFileInfo) Size() int64        { return int64(len(i.entry.data)) }
+func (i memFileInfo) Mode() os.FileMode  { return i.entry.mode }
+func (i memFileInfo) ModTime() time.Time { return i.entry.modTime }
+func (i memFileInfo) IsDir() bool        { return i.entry.mode.IsDir() }
+func (i memFileInfo) Sys() interface{}   { return nil }
+
+// MemFS implements FS with a filesystem stored in memory. Paths are cleaned
+// and treated as slash-separated, and relative paths are relative to the root.
+// Writing a file creates any missing parent directories. A MemFS is safe for
+// concurrent use.
+type MemFS struct {
+	mu    sync.Mutex
+	files map[string]memEntry
+}
+
+// NewMemFS returns an empty MemFS.
+func NewMemFS() *MemFS {
+	return &MemFS{files: map[string]memEntry{}}
+}
+
+// memPath returns name as a clean, absolute, slash-separated path.
+func memPath(name string) string {
+	return path.Clean("/" + filepath.ToSlash(name))
+}
+
+// entry returns the entry at p. The root always exists as a directory.
+func (fs *MemFS) entry(p string) (entry memEntry, ok bool) {
+	if p == "/" {
+		return memEntry{mode: os.ModeDir | 0777}, true
+	}
+	entry, ok = fs.files[p]
+	return entry, ok
+}
+
+func (fs *MemFS) ReadFile(name string) ([]byte, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	entry, ok := fs.entry(memPath(name))
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+	if entry.mode.IsDir() {
+		return nil, &os.PathError{Op: "read", Path: name, Err: errIsDir}
+	}
+	return append([]byte{}, entry.data...), nil
+}
+
+func (fs *MemFS) WriteFile(name string, data []byte, perm os.FileMode) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	p := memPath(name)
+	if entry, ok := fs.entry(p); ok && entry.mode.IsDir() {
+		return &os.PathError{Op: "open", Path: name, Err: errIsDir}
+	}
+	now := time.Now()
+	for dir := path.Dir(p); dir != "/"; dir = path.Dir(dir) {
+		entry, ok := fs.files[dir]
+		if !ok {
+			fs.files[dir] = memEntry{mode: os.ModeDir | 0777, modTime: now}
+			continue
+		}
+		if !entry.mode.IsDir() {
+			return &os.PathError{Op: "open", Path: name, Err: errNotDir}
+		}
+	}
+	fs.files[p] = memEntry{data: append([]byte{}, data...), mode: perm.Perm(), modTime: now}
+	return nil
+}
+
+func (fs *MemFS) ReadDir(name string) ([]os.FileInfo, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	dir := memPath(name)
+	entry, ok := fs.entry(dir)
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+	if !entry.mode.IsDir() {
+		return nil, &os.PathError{Op: "readdirent", Path: name, Err: errNotDir}
+	}
+	prefix := strings.TrimSuffix(dir, "/") + "/"
+	var infos []os.FileInfo
+	for p, entry := range fs.files {
+		if strings.HasPrefix(p, prefix) && !strings.Contains(p[len(prefix):], "/") {
+			infos = append(infos, memFileInfo{name: p[len(prefix):], entry: entry})
+		}
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name() < infos[j].Name() })
+	return infos, nil
+}
+
+func (fs *MemFS) Stat(name string) (os.FileInfo, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	p := memPath(name)
+	entry, ok := fs.entry(p)
+	if !ok {
+		return nil, &os.PathError{Op: "stat", Path: name, Err: os.ErrNotExist}
+	}
+	return memFileInfo{name: path.Base(p), entry: entry}, nil
+}
+
+// memError is an error that occurs within a MemFS.
+type memError string
+
+func (err memError) Error() string { return string(err) }
+
+const (
+	errIsDir  memError = "is a directory"
+	errNotDir memError = "not a directory"
+)
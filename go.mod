@@ -4,6 +4,8 @@ go 1.14
 
 require (
 	github.com/anaminus/but v0.2.0
+	github.com/andybalholm/brotli v1.0.1
+	github.com/klauspost/compress v1.11.13
 	github.com/robloxapi/rbxdump v0.3.2
 	github.com/robloxapi/rbxfile v0.2.0
 	github.com/robloxapi/types v0.0.0-20200805205844-0c0d16f0db67
@@ -190,10 +190,15 @@ func openFilteredLibs(l *lua.LState, libs []libFilter, upvalues ...lua.LValue) {
 		l.Call(1, 1)
 		table := l.CheckTable(1)
 		l.Pop(1)
-		for k, _ := table.Next(lua.LNil); k != lua.LNil; k, _ = table.Next(k) {
-			if !lib.Filter[k] {
-				table.RawSet(k, lua.LNil)
-			}
+		filterTable(table, lib.Filter)
+	}
+}
+
+// filterTable removes from table each key that is not in filter.
+func filterTable(table *lua.LTable, filter map[lua.LValue]bool) {
+	for k, _ := table.Next(lua.LNil); k != lua.LNil; k, _ = table.Next(k) {
+		if !filter[k] {
+			table.RawSet(k, lua.LNil)
 		}
 	}
 }
@@ -0,0 +1,244 @@
+package library
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// splitPath splits a slash-separated path into its elements. An empty path has
+// no elements.
+func splitPath(p string) []string {
+	if p == "" || p == "." {
+		return nil
+	}
+	return strings.Split(p, "/")
+}
+
+// validatePattern returns an error if a segment of pattern is malformed.
+func validatePattern(pattern []string) error {
+	for _, seg := range pattern {
+		if _, err := path.Match(seg, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchGlob returns whether the elements of name match the segments of
+// pattern. A "**" segment matches zero or more elements, and other segments
+// are matched according to path.Match.
+func matchGlob(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			pattern = pattern[1:]
+			if len(pattern) == 0 {
+				return true
+			}
+			for i := 0; i <= len(name); i++ {
+				if matchGlob(pattern, name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0
+}
+
+// matchGlobPrefix returns whether the elements of name could be the beginning
+// of a path that matches pattern.
+func matchGlobPrefix(pattern, name []string) bool {
+	for len(name) > 0 {
+		if len(pattern) == 0 {
+			return false
+		}
+		if pattern[0] == "**" {
+			return true
+		}
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return true
+}
+
+// hasElements returns whether name begins with the elements of prefix.
+func hasElements(name, prefix []string) bool {
+	if len(name) < len(prefix) {
+		return false
+	}
+	for i, elem := range prefix {
+		if name[i] != elem {
+			return false
+		}
+	}
+	return true
+}
+
+// splitGlob splits a glob pattern into the directory from which matching
+// begins, and the remaining segments of the pattern.
+func splitGlob(pattern string) (root string, segs []string) {
+	segs = strings.Split(filepath.ToSlash(pattern), "/")
+	i := 0
+	for ; i < len(segs)-1; i++ {
+		if strings.ContainsAny(segs[i], `*?[\`) {
+			break
+		}
+	}
+	root = strings.Join(segs[:i], "/")
+	switch {
+	case i > 0 && root == "":
+		root = "/"
+	case root == "":
+		root = "."
+	}
+	return filepath.FromSlash(root), segs[i:]
+}
+
+// ignoreRule is a single pattern of an ignore list.
+type ignoreRule struct {
+	// base contains the elements of the directory to which the rule applies.
+	base []string
+	// pattern contains the segments of the pattern.
+	pattern []string
+	// negate indicates whether matching paths are included rather than
+	// ignored.
+	negate bool
+	// dirOnly indicates whether the rule applies only to directories.
+	dirOnly bool
+}
+
+// ignoreList is a list of rules that determine whether a path is ignored,
+// similar to a gitignore file.
+type ignoreList []ignoreRule
+
+// Add parses line as a rule that applies to paths within the directory base,
+// and appends it to the list. Blank lines and comments are skipped.
+func (l *ignoreList) Add(base []string, line string) error {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return nil
+	}
+	rule := ignoreRule{base: base}
+	if strings.HasPrefix(line, "!") {
+		rule.negate = true
+		line = line[1:]
+	}
+	if strings.HasSuffix(line, "/") {
+		rule.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	if !strings.Contains(line, "/") {
+		// Unanchored patterns match at any depth.
+		line = "**/" + line
+	}
+	rule.pattern = splitPath(strings.TrimPrefix(line, "/"))
+	if err := validatePattern(rule.pattern); err != nil {
+		return err
+	}
+	*l = append(*l, rule)
+	return nil
+}
+
+// AddFile adds the rules from the file at path, which applies to paths within
+// the directory base. Does nothing if the file does not exist.
+func (l *ignoreList) AddFile(base []string, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if err := l.Add(base, scanner.Text()); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// Ignored returns whether the path with the given elements is ignored. The last
+// matching rule takes precedence.
+func (l ignoreList) Ignored(name []string, isDir bool) (ignored bool) {
+	for _, rule := range l {
+		if rule.dirOnly && !isDir {
+			continue
+		}
+		if !hasElements(name, rule.base) {
+			continue
+		}
+		if matchGlob(rule.pattern, name[len(rule.base):]) {
+			ignored = !rule.negate
+		}
+	}
+	return ignored
+}
+
+// walkOptions configures walkFiles.
+type walkOptions struct {
+	// Ignore contains patterns of paths to be ignored, relative to the root.
+	Ignore []string
+	// IgnoreFiles contains the names of files from which ignore patterns are
+	// read. A file applies to the directory it is located in.
+	IgnoreFiles []string
+}
+
+// walkFunc is called by walkFiles for each file. name contains the elements of
+// the path relative to the root. Returning filepath.SkipDir for a directory
+// skips its contents.
+type walkFunc func(path string, name []string, info os.FileInfo) error
+
+// walkFiles walks the file tree under root in lexical order, calling fn for
+// each file or directory that is not ignored. The root itself is not passed to
+// fn. Ignored directories are skipped entirely.
+func walkFiles(root string, opt walkOptions, fn walkFunc) error {
+	var ignore ignoreList
+	for _, line := range opt.Ignore {
+		if err := ignore.Add(nil, line); err != nil {
+			return err
+		}
+	}
+	return filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		name := splitPath(filepath.ToSlash(rel))
+		if len(name) > 0 {
+			if ignore.Ignored(name, info.IsDir()) {
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if err := fn(p, name, info); err != nil {
+				return err
+			}
+		}
+		if info.IsDir() {
+			for _, file := range opt.IgnoreFiles {
+				if err := ignore.AddFile(name, filepath.Join(p, file)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
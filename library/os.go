@@ -1,6 +1,8 @@
 package library
 
 import (
+	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -14,16 +16,59 @@ func init() { register(OS, 10) }
 var OS = rbxmk.Library{
 	Name: "os",
 	Open: func(s rbxmk.State) *lua.LTable {
-		lib := s.L.CreateTable(0, 5)
+		lib := s.L.CreateTable(0, 12)
 		lib.RawSetString("split", s.WrapFunc(osSplit))
 		lib.RawSetString("join", s.WrapFunc(osJoin))
 		lib.RawSetString("expand", s.WrapFunc(osExpand))
 		lib.RawSetString("getenv", s.WrapFunc(osGetenv))
 		lib.RawSetString("dir", s.WrapFunc(osDir))
+		lib.RawSetString("stat", s.WrapFunc(osStat))
+		lib.RawSetString("mkdir", s.WrapFunc(osMkdir))
+		lib.RawSetString("remove", s.WrapFunc(osRemove))
+		lib.RawSetString("copy", s.WrapFunc(osCopy))
+		lib.RawSetString("rename", s.WrapFunc(osRename))
+		lib.RawSetString("walk", s.WrapFunc(osWalk))
+		lib.RawSetString("glob", s.WrapFunc(osGlob))
+		filter := filteredOSLib
+		if s.AllowWrite() {
+			filter = make(map[lua.LValue]bool, len(filteredOSLib)+len(filteredOSWriteLib))
+			for k, v := range filteredOSLib {
+				filter[k] = v
+			}
+			for k, v := range filteredOSWriteLib {
+				filter[k] = v
+			}
+		}
+		filterTable(lib, filter)
 		return lib
 	},
 }
 
+// filteredOSLib contains the functions of the os library that are exposed.
+var filteredOSLib = map[lua.LValue]bool{
+	lua.LString("split"):  true,
+	lua.LString("join"):   true,
+	lua.LString("expand"): true,
+	lua.LString("getenv"): true,
+	lua.LString("dir"):    true,
+	lua.LString("stat"):   true,
+	lua.LString("walk"):   true,
+	lua.LString("glob"):   true,
+	// lua.LString("mkdir"):  true,
+	// lua.LString("remove"): true,
+	// lua.LString("copy"):   true,
+	// lua.LString("rename"): true,
+}
+
+// filteredOSWriteLib contains the functions of the os library that modify the
+// filesystem. They are exposed only if the world allows writing.
+var filteredOSWriteLib = map[lua.LValue]bool{
+	lua.LString("mkdir"):  true,
+	lua.LString("remove"): true,
+	lua.LString("copy"):   true,
+	lua.LString("rename"): true,
+}
+
 func osSplit(s rbxmk.State) int {
 	path := s.CheckString(1)
 	n := s.L.GetTop()
@@ -112,12 +157,188 @@ func osDir(s rbxmk.State) int {
 	}
 	tfiles := s.L.CreateTable(len(files), 0)
 	for _, info := range files {
-		tinfo := s.L.CreateTable(0, 4)
-		tinfo.RawSetString("Name", lua.LString(info.Name()))
-		tinfo.RawSetString("IsDir", lua.LBool(info.IsDir()))
-		tinfo.RawSetString("Size", lua.LNumber(info.Size()))
-		tinfo.RawSetString("ModTime", lua.LNumber(info.ModTime().Unix()))
-		tfiles.Append(tinfo)
+		tfiles.Append(s.CreateFileInfoTable(info))
+	}
+	s.L.Push(tfiles)
+	return 1
+}
+
+func osStat(s rbxmk.State) int {
+	info, err := os.Stat(s.CheckString(1))
+	if err != nil {
+		if os.IsNotExist(err) {
+			s.L.Push(lua.LNil)
+			return 1
+		}
+		return s.RaiseError(err.Error())
+	}
+	s.L.Push(s.CreateFileInfoTable(info))
+	return 1
+}
+
+func osMkdir(s rbxmk.State) int {
+	path := s.CheckString(1)
+	recursive := s.L.OptBool(2, false)
+	var err error
+	if recursive {
+		err = os.MkdirAll(path, 0777)
+	} else {
+		err = os.Mkdir(path, 0777)
+	}
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
+	return 0
+}
+
+func osRemove(s rbxmk.State) int {
+	path := s.CheckString(1)
+	recursive := s.L.OptBool(2, false)
+	var err error
+	if recursive {
+		err = os.RemoveAll(path)
+	} else {
+		err = os.Remove(path)
+	}
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
+	return 0
+}
+
+// copyFile copies the content and mode of the file at src to dst.
+func copyFile(src, dst string) (err error) {
+	r, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	info, err := r.Stat()
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return fmt.Errorf("%s is a directory", src)
+	}
+	w, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func osCopy(s rbxmk.State) int {
+	src := s.CheckString(1)
+	dst := s.CheckString(2)
+	if err := copyFile(src, dst); err != nil {
+		return s.RaiseError(err.Error())
+	}
+	return 0
+}
+
+func osRename(s rbxmk.State) int {
+	src := s.CheckString(1)
+	dst := s.CheckString(2)
+	if err := os.Rename(src, dst); err != nil {
+		return s.RaiseError(err.Error())
+	}
+	return 0
+}
+
+// pullWalkOptions gets from s.L an optional table of walk options at n.
+func pullWalkOptions(s rbxmk.State, n int) (opt walkOptions) {
+	switch v := s.L.Get(n).(type) {
+	case *lua.LNilType:
+		return opt
+	case *lua.LTable:
+		for _, field := range []string{"ignore", "ignoreFiles"} {
+			var list []string
+			switch lv := v.RawGetString(field).(type) {
+			case *lua.LNilType:
+				continue
+			case *lua.LTable:
+				for i := 1; i <= lv.Len(); i++ {
+					str, ok := lv.RawGetInt(i).(lua.LString)
+					if !ok {
+						s.L.ArgError(n, "field "+field+": string expected in array")
+						return opt
+					}
+					list = append(list, string(str))
+				}
+			default:
+				s.L.ArgError(n, "field "+field+": table expected")
+				return opt
+			}
+			switch field {
+			case "ignore":
+				opt.Ignore = list
+			case "ignoreFiles":
+				opt.IgnoreFiles = list
+			}
+		}
+		return opt
+	default:
+		rbxmk.TypeError(s.L, n, "table")
+		return opt
+	}
+}
+
+// createPathInfoTable returns a table containing the fields of info, along with
+// the path of the file.
+func createPathInfoTable(s rbxmk.State, path string, info os.FileInfo) *lua.LTable {
+	tinfo := s.CreateFileInfoTable(info)
+	tinfo.RawSetString("Path", lua.LString(path))
+	return tinfo
+}
+
+func osWalk(s rbxmk.State) int {
+	root := s.CheckString(1)
+	fn := s.L.CheckFunction(2)
+	opt := pullWalkOptions(s, 3)
+	err := walkFiles(root, opt, func(path string, name []string, info os.FileInfo) error {
+		s.L.Push(fn)
+		s.L.Push(lua.LString(path))
+		s.L.Push(createPathInfoTable(s, path, info))
+		s.L.Call(2, 1)
+		skip := s.L.Get(-1) == lua.LFalse
+		s.L.Pop(1)
+		if skip && info.IsDir() {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
+	return 0
+}
+
+func osGlob(s rbxmk.State) int {
+	root, pattern := splitGlob(s.CheckString(1))
+	opt := pullWalkOptions(s, 2)
+	if err := validatePattern(pattern); err != nil {
+		return s.RaiseError(err.Error())
+	}
+	tfiles := s.L.CreateTable(0, 0)
+	if _, err := os.Stat(root); os.IsNotExist(err) {
+		s.L.Push(tfiles)
+		return 1
+	}
+	err := walkFiles(root, opt, func(path string, name []string, info os.FileInfo) error {
+		if matchGlob(pattern, name) {
+			tfiles.Append(createPathInfoTable(s, path, info))
+		}
+		if info.IsDir() && !matchGlobPrefix(pattern, name) {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	if err != nil {
+		return s.RaiseError(err.Error())
 	}
 	s.L.Push(tfiles)
 	return 1
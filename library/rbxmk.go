@@ -18,14 +18,19 @@ func init() { register(RBXMK, 0) }
 var RBXMK = rbxmk.Library{
 	Name: "rbxmk",
 	Open: func(s rbxmk.State) *lua.LTable {
-		lib := s.L.CreateTable(0, 11)
+		lib := s.L.CreateTable(0, 16)
 		lib.RawSetString("loadFile", s.WrapFunc(rbxmkLoadFile))
 		lib.RawSetString("loadString", s.WrapFunc(rbxmkLoadString))
 		lib.RawSetString("runFile", s.WrapFunc(rbxmkRunFile))
 		lib.RawSetString("runString", s.WrapFunc(rbxmkRunString))
 		lib.RawSetString("newDesc", s.WrapFunc(rbxmkNewDesc))
+		lib.RawSetString("defaultDesc", s.WrapFunc(rbxmkDefaultDesc))
 		lib.RawSetString("diffDesc", s.WrapFunc(rbxmkDiffDesc))
 		lib.RawSetString("patchDesc", s.WrapFunc(rbxmkPatchDesc))
+		lib.RawSetString("mergeDesc", s.WrapFunc(rbxmkMergeDesc))
+		lib.RawSetString("validate", s.WrapFunc(rbxmkValidate))
+		lib.RawSetString("normalize", s.WrapFunc(rbxmkNormalize))
+		lib.RawSetString("coerce", s.WrapFunc(rbxmkCoerce))
 		lib.RawSetString("encodeFormat", s.WrapFunc(rbxmkEncodeFormat))
 		lib.RawSetString("decodeFormat", s.WrapFunc(rbxmkDecodeFormat))
 		lib.RawSetString("readSource", s.WrapFunc(rbxmkReadSource))
@@ -204,6 +209,14 @@ func rbxmkNewDesc(s rbxmk.State) int {
 	}
 }
 
+func rbxmkDefaultDesc(s rbxmk.State) int {
+	desc, err := s.DefaultDesc()
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
+	return s.Push(desc)
+}
+
 func rbxmkDiffDesc(s rbxmk.State) int {
 	var prev *rbxdump.Root
 	var next *rbxdump.Root
@@ -236,6 +249,74 @@ func rbxmkPatchDesc(s rbxmk.State) int {
 	return 0
 }
 
+func rbxmkMergeDesc(s rbxmk.State) int {
+	base := s.Pull(1, "RootDesc").(*rtypes.RootDesc)
+	overlay := s.Pull(2, "RootDesc").(*rtypes.RootDesc)
+	conflict := rtypes.ConflictOverlay
+	switch opt := s.L.Get(3).(type) {
+	case *lua.LNilType:
+	case *lua.LTable:
+		switch lv := opt.RawGetString("conflict").(type) {
+		case *lua.LNilType:
+		case lua.LString:
+			switch lv {
+			case "overlay":
+				conflict = rtypes.ConflictOverlay
+			case "base":
+				conflict = rtypes.ConflictBase
+			case "error":
+				conflict = rtypes.ConflictError
+			default:
+				return s.RaiseError("field conflict: unknown value %q", string(lv))
+			}
+		default:
+			return s.RaiseError("field conflict: string expected")
+		}
+	default:
+		rbxmk.TypeError(s.L, 3, "table")
+		return 0
+	}
+	desc, err := base.Merge(overlay, conflict)
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
+	return s.Push(desc)
+}
+
+func rbxmkValidate(s rbxmk.State) int {
+	inst := s.Pull(1, "Instance").(*rtypes.Instance)
+	desc, _ := s.PullOpt(2, "RootDesc", nil).(*rtypes.RootDesc)
+	if desc == nil {
+		if desc = s.Desc(inst); desc == nil {
+			return s.RaiseError("no descriptor")
+		}
+	}
+	return s.Push(rtypes.ProblemArray(desc.Validate(inst)))
+}
+
+func rbxmkCoerce(s rbxmk.State) int {
+	inst := s.Pull(1, "Instance").(*rtypes.Instance)
+	desc, _ := s.PullOpt(2, "RootDesc", nil).(*rtypes.RootDesc)
+	if desc == nil {
+		if desc = s.Desc(inst); desc == nil {
+			return s.RaiseError("no descriptor")
+		}
+	}
+	return s.Push(rtypes.ProblemArray(desc.Coerce(inst)))
+}
+
+func rbxmkNormalize(s rbxmk.State) int {
+	inst := s.Pull(1, "Instance").(*rtypes.Instance)
+	desc, _ := s.PullOpt(2, "RootDesc", nil).(*rtypes.RootDesc)
+	if desc == nil {
+		if desc = s.Desc(inst); desc == nil {
+			return s.RaiseError("no descriptor")
+		}
+	}
+	desc.Normalize(inst)
+	return 0
+}
+
 func rbxmkEncodeFormat(s rbxmk.State) int {
 	name := string(s.Pull(1, "string").(types.String))
 	format := s.Format(name)
@@ -245,7 +326,7 @@ func rbxmkEncodeFormat(s rbxmk.State) int {
 	if format.Encode == nil {
 		return s.RaiseError("cannot encode with format %s", name)
 	}
-	b, err := format.Encode(rbxmk.FormatOptions{}, s.Pull(2, "Variant"))
+	b, err := format.Encode(s.PullFormatOptions(3), s.Pull(2, "Variant"))
 	if err != nil {
 		return s.RaiseError(err.Error())
 	}
@@ -261,10 +342,14 @@ func rbxmkDecodeFormat(s rbxmk.State) int {
 	if format.Decode == nil {
 		return s.RaiseError("cannot decode with format %s", name)
 	}
-	v, err := format.Decode(rbxmk.FormatOptions{}, []byte(s.Pull(2, "BinaryString").(types.BinaryString)))
+	options := s.PullFormatOptions(3)
+	v, err := format.Decode(options, []byte(s.Pull(2, "BinaryString").(types.BinaryString)))
 	if err != nil {
 		return s.RaiseError(err.Error())
 	}
+	if options.Problems != nil {
+		return s.Push(v) + s.Push(rtypes.ProblemArray(*options.Problems))
+	}
 	return s.Push(v)
 }
 
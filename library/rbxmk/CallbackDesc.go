@@ -109,6 +109,15 @@ func CallbackDesc() Reflector {
 				desc.UnsetTag(tags...)
 				return 0
 			}},
+			"Copy": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(rtypes.CallbackDesc)
+				return s.Push(desc.Copy())
+			}},
+			"Equal": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(rtypes.CallbackDesc)
+				other := s.Pull(2, "CallbackDesc").(rtypes.CallbackDesc)
+				return s.Push(types.Bool(desc.Equal(other)))
+			}},
 		},
 	}
 }
@@ -148,6 +148,15 @@ func ClassDesc() Reflector {
 				desc.UnsetTag(tags...)
 				return 0
 			}},
+			"Copy": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(rtypes.ClassDesc)
+				return s.Push(desc.Copy())
+			}},
+			"Equal": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(rtypes.ClassDesc)
+				other := s.Pull(2, "ClassDesc").(rtypes.ClassDesc)
+				return s.Push(types.Bool(desc.Equal(other)))
+			}},
 		},
 	}
 }
@@ -108,6 +108,15 @@ func EnumDesc() Reflector {
 				desc.UnsetTag(tags...)
 				return 0
 			}},
+			"Copy": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(rtypes.EnumDesc)
+				return s.Push(desc.Copy())
+			}},
+			"Equal": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(rtypes.EnumDesc)
+				other := s.Pull(2, "EnumDesc").(rtypes.EnumDesc)
+				return s.Push(types.Bool(desc.Equal(other)))
+			}},
 		},
 	}
 }
@@ -84,6 +84,15 @@ func EnumItemDesc() Reflector {
 				desc.UnsetTag(tags...)
 				return 0
 			}},
+			"Copy": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(rtypes.EnumItemDesc)
+				return s.Push(desc.Copy())
+			}},
+			"Equal": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(rtypes.EnumItemDesc)
+				other := s.Pull(2, "EnumItemDesc").(rtypes.EnumItemDesc)
+				return s.Push(types.Bool(desc.Equal(other)))
+			}},
 		},
 	}
 }
@@ -98,6 +98,15 @@ func EventDesc() Reflector {
 				desc.UnsetTag(tags...)
 				return 0
 			}},
+			"Copy": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(rtypes.EventDesc)
+				return s.Push(desc.Copy())
+			}},
+			"Equal": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(rtypes.EventDesc)
+				other := s.Pull(2, "EventDesc").(rtypes.EventDesc)
+				return s.Push(types.Bool(desc.Equal(other)))
+			}},
 		},
 	}
 }
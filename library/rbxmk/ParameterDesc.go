@@ -44,6 +44,15 @@ func ParameterDesc() Reflector {
 					return s.Push(types.String(desc.Default))
 				},
 			},
+			"Copy": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(rtypes.ParameterDesc)
+				return s.Push(desc.Copy())
+			}},
+			"Equal": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(rtypes.ParameterDesc)
+				other := s.Pull(2, "ParameterDesc").(rtypes.ParameterDesc)
+				return s.Push(types.Bool(desc.Equal(other)))
+			}},
 		},
 	}
 }
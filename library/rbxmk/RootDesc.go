@@ -66,6 +66,59 @@ func RootDesc() Reflector {
 				delete(desc.Classes, name)
 				return s.Push(types.True)
 			}},
+			"Members": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(*rtypes.RootDesc)
+				name := string(s.Pull(2, "string").(types.String))
+				var inherited bool
+				switch opt := s.L.Get(3).(type) {
+				case *lua.LNilType:
+				case *lua.LTable:
+					switch lv := opt.RawGetString("inherited").(type) {
+					case *lua.LNilType:
+					case lua.LBool:
+						inherited = bool(lv)
+					default:
+						s.L.ArgError(3, "field inherited: bool expected")
+						return 0
+					}
+				default:
+					TypeError(s.L, 3, "table")
+					return 0
+				}
+				members := desc.Members(name, inherited)
+				array := make(rtypes.Array, len(members))
+				for i, member := range members {
+					array[i] = rtypes.NewMemberDesc(member)
+				}
+				return s.Push(array)
+			}},
+			"Superclasses": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(*rtypes.RootDesc)
+				name := string(s.Pull(2, "string").(types.String))
+				classes := desc.Superclasses(name)
+				array := make(rtypes.Array, len(classes))
+				for i, class := range classes {
+					array[i] = rtypes.ClassDesc{Class: class}
+				}
+				return s.Push(array)
+			}},
+			"Subclasses": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(*rtypes.RootDesc)
+				name := string(s.Pull(2, "string").(types.String))
+				recursive := bool(s.PullOpt(3, "bool", types.False).(types.Bool))
+				classes := desc.Subclasses(name, recursive)
+				array := make(rtypes.Array, len(classes))
+				for i, class := range classes {
+					array[i] = rtypes.ClassDesc{Class: class}
+				}
+				return s.Push(array)
+			}},
+			"IsA": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(*rtypes.RootDesc)
+				class := string(s.Pull(2, "string").(types.String))
+				super := string(s.Pull(3, "string").(types.String))
+				return s.Push(types.Bool(desc.IsA(class, super)))
+			}},
 			"Enum": Member{Method: true, Get: func(s State, v types.Value) int {
 				desc := v.(*rtypes.RootDesc)
 				name := string(s.Pull(2, "string").(types.String))
@@ -107,11 +160,98 @@ func RootDesc() Reflector {
 				delete(desc.Enums, name)
 				return s.Push(types.True)
 			}},
+			"Defaults": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(*rtypes.RootDesc)
+				name := string(s.Pull(2, "string").(types.String))
+				defaults := desc.ClassDefaults(name)
+				dict := make(rtypes.Dictionary, len(defaults))
+				for prop, value := range defaults {
+					dict[prop] = value
+				}
+				return s.Push(dict)
+			}},
+			"SetDefaults": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(*rtypes.RootDesc)
+				switch v := s.PullAnyOf(2, "Instance", "Objects", "nil").(type) {
+				case rtypes.NilType:
+					desc.Defaults = nil
+				case *rtypes.Instance:
+					if v.IsDataModel() {
+						desc.SetDefaults(v.Children())
+					} else {
+						desc.SetDefaults([]*rtypes.Instance{v})
+					}
+				case rtypes.Objects:
+					desc.SetDefaults(v)
+				}
+				return 0
+			}},
+			"Filter": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(*rtypes.RootDesc)
+				var keep rtypes.DescFilter
+				switch opt := s.L.Get(2).(type) {
+				case *lua.LFunction:
+					keep = func(element, parent types.Value) bool {
+						s.L.Push(opt)
+						s.Push(element)
+						if parent == nil {
+							s.L.Push(lua.LNil)
+						} else {
+							s.Push(parent)
+						}
+						s.L.Call(2, 1)
+						ok := lua.LVAsBool(s.L.Get(-1))
+						s.L.Pop(1)
+						return ok
+					}
+				case *lua.LTable:
+					var filters []rtypes.DescFilter
+					for _, field := range []string{"security", "excludeTags"} {
+						var list []string
+						switch lv := opt.RawGetString(field).(type) {
+						case *lua.LNilType:
+							continue
+						case *lua.LTable:
+							for i := 1; i <= lv.Len(); i++ {
+								str, ok := lv.RawGetInt(i).(lua.LString)
+								if !ok {
+									s.L.ArgError(2, "field "+field+": string expected in array")
+									return 0
+								}
+								list = append(list, string(str))
+							}
+						default:
+							s.L.ArgError(2, "field "+field+": table expected")
+							return 0
+						}
+						switch field {
+						case "security":
+							filters = append(filters, rtypes.FilterSecurity(list...))
+						case "excludeTags":
+							filters = append(filters, rtypes.FilterTags(list...))
+						}
+					}
+					keep = rtypes.FilterAll(filters...)
+				default:
+					TypeError(s.L, 2, "table or function")
+					return 0
+				}
+				return s.Push(desc.Filter(keep))
+			}},
 			"EnumTypes": Member{Method: true, Get: func(s State, v types.Value) int {
 				desc := v.(*rtypes.RootDesc)
 				desc.GenerateEnumTypes()
 				return s.Push(desc.EnumTypes)
 			}},
+			"Copy": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(*rtypes.RootDesc)
+				return s.Push(desc.Copy())
+			}},
+			"Equal": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(*rtypes.RootDesc)
+				other := s.Pull(2, "RootDesc").(*rtypes.RootDesc)
+				return s.Push(types.Bool(desc.Equal(other)))
+			}},
 		},
 	}
 }
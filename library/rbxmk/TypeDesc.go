@@ -34,6 +34,15 @@ func TypeDesc() Reflector {
 					return s.Push(types.String(desc.Embedded.Name))
 				},
 			},
+			"Copy": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(rtypes.TypeDesc)
+				return s.Push(desc.Copy())
+			}},
+			"Equal": Member{Method: true, Get: func(s State, v types.Value) int {
+				desc := v.(rtypes.TypeDesc)
+				other := s.Pull(2, "TypeDesc").(rtypes.TypeDesc)
+				return s.Push(types.Bool(desc.Equal(other)))
+			}},
 		},
 	}
 }
@@ -39,65 +39,7 @@ func convertType(s State, t string, v types.Value) (nv types.Value, ok bool) {
 	if s.Reflector(t).Name == "" {
 		return v, false
 	}
-	switch t {
-	case "int":
-		switch v := v.(type) {
-		case types.Intlike:
-			return types.Int(v.Intlike()), true
-		case types.Numberlike:
-			return types.Int(v.Numberlike()), true
-		}
-	case "int64":
-		switch v := v.(type) {
-		case types.Intlike:
-			return types.Int64(v.Intlike()), true
-		case types.Numberlike:
-			return types.Int64(v.Numberlike()), true
-		}
-	case "float":
-		switch v := v.(type) {
-		case types.Numberlike:
-			return types.Float(v.Numberlike()), true
-		case types.Intlike:
-			return types.Float(v.Intlike()), true
-		}
-	case "double":
-		switch v := v.(type) {
-		case types.Numberlike:
-			return types.Double(v.Numberlike()), true
-		case types.Intlike:
-			return types.Double(v.Intlike()), true
-		}
-	case "string":
-		if v, ok := v.(types.Stringlike); ok {
-			return types.String(v.Stringlike()), true
-		}
-	case "BinaryString":
-		if v, ok := v.(types.Stringlike); ok {
-			return types.BinaryString(v.Stringlike()), true
-		}
-	case "ProtectedString":
-		if v, ok := v.(types.Stringlike); ok {
-			return types.ProtectedString(v.Stringlike()), true
-		}
-	case "Content":
-		if v, ok := v.(types.Stringlike); ok {
-			return types.Content(v.Stringlike()), true
-		}
-	case "SharedString":
-		if v, ok := v.(types.Stringlike); ok {
-			return types.SharedString(v.Stringlike()), true
-		}
-	case "Color3":
-		if v, ok := v.(rtypes.Color3uint8); ok {
-			return types.Color3(v), true
-		}
-	case "Color3uint8":
-		if v, ok := v.(types.Color3); ok {
-			return rtypes.Color3uint8(v), true
-		}
-	}
-	return v, false
+	return rtypes.ConvertType(t, v)
 }
 
 func checkEnumDesc(s State, desc *rtypes.RootDesc, name, class, prop string) *rtypes.Enum {
@@ -219,6 +161,11 @@ func Instance() Reflector {
 						service := inst.FindFirstChildOfClass(className, false)
 						if service == nil {
 							service = rtypes.NewInstance(className, nil)
+							if desc != nil {
+								for name, value := range desc.ClassDefaults(className) {
+									service.Set(name, value)
+								}
+							}
 							service.IsService = true
 							service.SetName(className)
 							service.SetParent(inst)
@@ -524,6 +471,57 @@ func Instance() Reflector {
 			"GetFullName": Member{Method: true, Get: func(s State, v types.Value) int {
 				return s.Push(types.String(v.(*rtypes.Instance).GetFullName()))
 			}},
+			"GetProperties": Member{Method: true, Get: func(s State, v types.Value) int {
+				inst := v.(*rtypes.Instance)
+				all := bool(s.PullOpt(2, "bool", types.False).(types.Bool))
+				props := inst.Properties()
+				dict := make(rtypes.Dictionary, len(props))
+				for name, value := range props {
+					dict[name] = value
+				}
+				desc := s.Desc(inst)
+				if desc == nil {
+					return s.Push(dict)
+				}
+				if all {
+					for name, value := range desc.ClassDefaults(inst.ClassName) {
+						if _, ok := dict[name]; !ok {
+							dict[name] = value
+						}
+					}
+				}
+				classDesc := desc.Classes[inst.ClassName]
+				for classDesc != nil {
+					for _, member := range classDesc.Members {
+						propDesc, ok := member.(*rbxdump.Property)
+						if !ok {
+							continue
+						}
+						value, ok := dict[propDesc.Name]
+						if !ok {
+							if !all {
+								continue
+							}
+							if value = desc.ZeroValue(propDesc.ValueType); value == nil {
+								continue
+							}
+							dict[propDesc.Name] = value
+						}
+						// Return enum values as items, as indexing does.
+						token, ok := value.(types.Token)
+						if !ok || propDesc.ValueType.Category != "Enum" || desc.EnumTypes == nil {
+							continue
+						}
+						if enum := desc.EnumTypes.Enum(propDesc.ValueType.Name); enum != nil {
+							if item := enum.Value(int(token)); item != nil {
+								dict[propDesc.Name] = item
+							}
+						}
+					}
+					classDesc = desc.Classes[classDesc.Superclass]
+				}
+				return s.Push(dict)
+			}},
 			"IsAncestorOf": Member{Method: true, Get: func(s State, v types.Value) int {
 				descendant := s.Pull(2, "Instance").(*rtypes.Instance)
 				return s.Push(types.Bool(v.(*rtypes.Instance).IsAncestorOf(descendant)))
@@ -551,8 +549,9 @@ func Instance() Reflector {
 						desc = v
 					}
 				}
+				var checkDesc *rtypes.RootDesc
 				if !blocked {
-					checkDesc := desc
+					checkDesc = desc
 					if checkDesc == nil {
 						// Use global descriptor, if available.
 						checkDesc = s.Desc(nil)
@@ -565,6 +564,11 @@ func Instance() Reflector {
 					}
 				}
 				inst := rtypes.NewInstance(className, parent)
+				if checkDesc != nil {
+					for name, value := range checkDesc.ClassDefaults(className) {
+						inst.Set(name, value)
+					}
+				}
 				inst.SetDesc(desc, blocked)
 				return s.Push(inst)
 			},
@@ -9,6 +9,7 @@ import (
 
 	"github.com/anaminus/but"
 	"github.com/anaminus/rbxmk"
+	"github.com/anaminus/rbxmk/dump"
 	"github.com/anaminus/rbxmk/formats"
 	"github.com/anaminus/rbxmk/library"
 	"github.com/anaminus/rbxmk/sources"
@@ -75,6 +76,8 @@ func Main(args []string, std Std, init func(rbxmk.State)) error {
 		fmt.Fprintf(flagset.Output(), CommandUsage)
 		flagset.PrintDefaults()
 	}
+	allowWrite := flagset.Bool("allow-write", false, "Enable os functions that modify the filesystem, such as os.remove.")
+	memfs := flagset.Bool("memfs", false, "Route the file source through an in-memory filesystem. The os library and other sources still use the disk.")
 	flagset.Parse(args[1:])
 	args = flagset.Args()
 	if len(args) == 0 {
@@ -89,6 +92,12 @@ func Main(args []string, std Std, init func(rbxmk.State)) error {
 		SkipOpenLibs:        true,
 		IncludeGoStackTrace: false,
 	}))
+	world.SetDefaultDesc(dump.Load)
+	world.SetStd(std.in, std.out, std.err)
+	world.SetAllowWrite(*allowWrite)
+	if *memfs {
+		world.SetFS(world.MemFS())
+	}
 	for _, f := range formats.All() {
 		world.RegisterFormat(f())
 	}
@@ -144,7 +144,8 @@ func initMain(s rbxmk.State, t *testing.T) {
 
 // TestScripts runs each .lua file in testdata as a Lua script. If the first
 // line starts with a comment that contains "fail", then the script is expected
-// to throw an error. All scripts receive the arguments from scriptArguments.
+// to throw an error. All scripts receive the arguments from scriptArguments,
+// and are run with the -allow-write flag.
 func TestScripts(t *testing.T) {
 	var files []string
 	err := filepath.Walk(testdata, func(path string, info os.FileInfo, err error) error {
@@ -165,7 +166,7 @@ func TestScripts(t *testing.T) {
 		t.Run(filepath.ToSlash(file), func(t *testing.T) {
 			args := scriptArguments
 			args[1] = file
-			err := Main(args[:], Std{
+			err := Main(append([]string{args[0], "-allow-write"}, args[1:]...), Std{
 				in:  os.Stdin,
 				out: os.Stdout,
 				err: os.Stderr,
@@ -176,3 +177,35 @@ func TestScripts(t *testing.T) {
 		})
 	}
 }
+
+// TestAllowWrite runs a script without the -allow-write flag, which causes os
+// functions that modify the filesystem to be excluded.
+func TestAllowWrite(t *testing.T) {
+	file := filepath.Join(testdata, "_allowwrite.lua")
+	err := Main([]string{"rbxmk_test", file}, Std{
+		in:  os.Stdin,
+		out: os.Stdout,
+		err: os.Stderr,
+	}, func(s rbxmk.State) { initMain(s, t) })
+	if err != nil {
+		t.Errorf("script %s: %s", file, err)
+	}
+}
+
+// TestMemFS runs a script with the -memfs flag, which causes the file source to
+// use an in-memory filesystem.
+func TestMemFS(t *testing.T) {
+	file := filepath.Join(testdata, "_memfs.lua")
+	err := Main([]string{"rbxmk_test", "-memfs", file}, Std{
+		in:  os.Stdin,
+		out: os.Stdout,
+		err: os.Stderr,
+	}, func(s rbxmk.State) { initMain(s, t) })
+	if err != nil {
+		t.Errorf("script %s: %s", file, err)
+	}
+	if _, err := os.Stat("memfs_output.txt"); !os.IsNotExist(err) {
+		os.Remove("memfs_output.txt")
+		t.Errorf("expected file to not be written to disk")
+	}
+}
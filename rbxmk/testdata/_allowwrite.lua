@@ -0,0 +1,6 @@
+for _, name in ipairs({"mkdir", "remove", "copy", "rename"}) do
+	T.Pass("os." .. name .. " is excluded without -allow-write", os[name] == nil)
+end
+for _, name in ipairs({"split", "join", "expand", "getenv", "dir", "stat", "walk", "glob"}) do
+	T.Pass("os." .. name .. " is included without -allow-write", type(os[name]) == "function")
+end
@@ -0,0 +1,9 @@
+-- Run by TestMemFS with the -memfs flag.
+file.write("memfs_output.txt", "staged", "txt")
+T.Pass("file writes to memory",
+	mem.read("memfs_output.txt", "txt") == "staged")
+T.Pass("file reads from memory",
+	file.read("memfs_output.txt") == "staged")
+-- Only the file source is redirected, so os still sees the disk.
+T.Pass("file does not write to disk",
+	os.stat("memfs_output.txt") == nil)
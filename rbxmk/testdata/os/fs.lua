@@ -0,0 +1,45 @@
+local root = os.join(os.expand("$tmp"), "rbxmk_test_fs")
+os.remove(root, true)
+
+T.Pass("stat returns nil for missing path",
+	os.stat(root) == nil)
+T.Fail("mkdir without recursive fails for missing parent",
+	function() os.mkdir(os.join(root, "a", "b")) end)
+T.Pass("mkdir creates directories recursively",
+	function() os.mkdir(os.join(root, "a", "b"), true) return true end)
+T.Pass("stat returns info of directory",
+	function()
+		local info = os.stat(os.join(root, "a"))
+		return info.Name == "a" and info.IsDir
+	end)
+
+local src = os.join(root, "a", "file.txt")
+file.write(src, "content", "txt")
+T.Pass("stat returns info of file",
+	function()
+		local info = os.stat(src)
+		return info.Name == "file.txt" and not info.IsDir and info.Size == 7
+	end)
+
+local dst = os.join(root, "a", "b", "copy.txt")
+os.copy(src, dst)
+T.Pass("copy copies file content",
+	file.read(dst, "txt") == "content" and os.stat(src) ~= nil)
+T.Fail("copy fails for directories",
+	function() os.copy(os.join(root, "a", "b"), os.join(root, "c")) end)
+
+local renamed = os.join(root, "renamed.txt")
+os.rename(dst, renamed)
+T.Pass("rename moves file",
+	os.stat(dst) == nil and file.read(renamed, "txt") == "content")
+
+os.remove(renamed)
+T.Pass("remove removes file",
+	os.stat(renamed) == nil)
+T.Fail("remove without recursive fails for non-empty directory",
+	function() os.remove(os.join(root, "a")) end)
+os.remove(root, true)
+T.Pass("remove removes directories recursively",
+	os.stat(root) == nil)
+T.Fail("remove fails for missing path",
+	function() os.remove(root) end)
@@ -0,0 +1,66 @@
+local root = os.join(os.expand("$tmp"), "rbxmk_test_walk")
+os.remove(root, true)
+
+local function write(path, content)
+	local full = os.join(root, path)
+	os.mkdir(os.split(full, "dir"), true)
+	file.write(full, content or "", "txt")
+end
+
+write("src/main.lua")
+write("src/lib/util.lua")
+write("src/lib/util.txt")
+write("src/build/out.lua")
+write("src/.gitignore", "build/\n*.tmp\n")
+write("src/lib/cache.tmp")
+write("src/lib/keep.tmp")
+write("src/lib/.gitignore", "!keep.tmp\n")
+write("docs/readme.md")
+
+local function paths(files)
+	local list = {}
+	for _, info in ipairs(files) do
+		local path = string.gsub(string.sub(info.Path, #root + 2), "\\", "/")
+		table.insert(list, path)
+	end
+	table.sort(list)
+	return table.concat(list, ",")
+end
+
+local walked = {}
+os.walk(root, function(path, info)
+	table.insert(walked, info)
+	if info.Name == "docs" then
+		return false
+	end
+end)
+T.Pass("walk visits each file",
+	paths(walked) == "docs,src,src/.gitignore,src/build,src/build/out.lua,src/lib,src/lib/.gitignore,src/lib/cache.tmp,src/lib/keep.tmp,src/lib/util.lua,src/lib/util.txt,src/main.lua")
+T.Pass("walk passes file info",
+	function()
+		for _, info in ipairs(walked) do
+			if info.Name == "main.lua" then
+				return not info.IsDir and info.Size == 0 and info.ModTime > 0
+			end
+		end
+	end)
+
+local walked = {}
+os.walk(root, function(path, info) table.insert(walked, info) end, {ignore = {"src/lib/", "*.md"}})
+T.Pass("walk skips ignored files",
+	paths(walked) == "docs,src,src/.gitignore,src/build,src/build/out.lua,src/main.lua")
+
+T.Pass("glob matches files in directory",
+	paths(os.glob(os.join(root, "src", "*.lua"))) == "src/main.lua")
+T.Pass("glob matches files recursively",
+	paths(os.glob(os.join(root, "src", "**", "*.lua"))) == "src/build/out.lua,src/lib/util.lua,src/main.lua")
+T.Pass("glob matches directories",
+	paths(os.glob(os.join(root, "*"))) == "docs,src")
+T.Pass("glob reads ignore files",
+	paths(os.glob(os.join(root, "**", "*"), {ignoreFiles = {".gitignore"}, ignore = {".gitignore"}})) == "docs,docs/readme.md,src,src/lib,src/lib/keep.tmp,src/lib/util.lua,src/lib/util.txt,src/main.lua")
+T.Pass("glob returns empty list for missing root",
+	#os.glob(os.join(root, "missing", "*")) == 0)
+T.Fail("glob fails for malformed pattern",
+	function() os.glob(os.join(root, "[")) end)
+
+os.remove(root, true)
@@ -0,0 +1,60 @@
+local desc = file.read(os.expand("$sd/../dump.desc.json"))
+
+local source = [[
+<roblox version="4">
+	<Item class="Part">
+		<Properties>
+			<string name="Name">Part</string>
+			<double name="Transparency">0.5</double>
+			<int name="Material">256</int>
+			<Color3uint8 name="Color">4288914085</Color3uint8>
+			<string name="Locked">yes</string>
+			<bool name="Foobar">true</bool>
+		</Properties>
+	</Item>
+</roblox>]]
+
+local function find(problems, path, property)
+	for _, problem in ipairs(problems) do
+		if problem.Path == path and problem.Property == property then
+			return problem
+		end
+	end
+	return nil
+end
+
+local part = rbxmk.decodeFormat("rbxmx", source):GetChildren()[1]
+T.Pass("decoded values do not match descriptor",
+	function() return #rbxmk.validate(part, desc) == 5 end)
+T.Fail("coerce requires a descriptor",
+	function() rbxmk.coerce(part) end)
+
+local problems = rbxmk.coerce(part, desc)
+T.Pass("coerce reports values that cannot be coerced",
+	#problems == 1 and find(problems, "Part", "Locked") ~= nil)
+T.Pass("coerce converts values to declared types",
+	function()
+		local problems = rbxmk.validate(part, desc)
+		return #problems == 2 and find(problems, "Part", "Locked") and find(problems, "Part", "Foobar")
+	end)
+T.Pass("coerce leaves uncoerced values unchanged",
+	function()
+		local problem = find(rbxmk.validate(part, desc), "Part", "Locked")
+		return problem.Message == "value type string does not match property type bool"
+	end)
+
+local value, problems = rbxmk.decodeFormat("rbxmx", source)
+T.Pass("decoding does not coerce by default",
+	problems == nil and #rbxmk.validate(value, desc) == 5)
+
+local value, problems = rbxmk.decodeFormat("rbxmx", source, {coerce = true, desc = desc})
+T.Pass("coerce option returns problems",
+	type(problems) == "table" and #problems == 1 and find(problems, "Part", "Locked") ~= nil)
+T.Pass("coerce option converts values to declared types",
+	#rbxmk.validate(value, desc) == 2)
+
+T.Pass("no global descriptor", rbxmk.globalDesc == nil)
+T.Fail("coerce option requires a descriptor",
+	function() rbxmk.decodeFormat("rbxmx", source, {coerce = true}) end)
+T.Fail("coerce option must be a bool",
+	function() rbxmk.decodeFormat("rbxmx", source, {coerce = 1}) end)
@@ -0,0 +1,36 @@
+local root = os.join(os.expand("$tmp"), "rbxmk_test_compress")
+os.remove(root, true)
+os.mkdir(root)
+
+for _, ext in ipairs({"gz", "zst", "br"}) do
+	local text = string.rep("compressible ", 64)
+	local b = rbxmk.encodeFormat(ext, text)
+	T.Pass(ext .. ": encode compresses", #b < #text)
+	T.Pass(ext .. ": decode decompresses", rbxmk.decodeFormat(ext, b) == text)
+	T.Pass(ext .. ": layered format round-trips",
+		rbxmk.decodeFormat("txt." .. ext, rbxmk.encodeFormat("txt." .. ext, text)) == text)
+	T.Pass(ext .. ": layers nest",
+		rbxmk.decodeFormat("txt.gz." .. ext, rbxmk.encodeFormat("txt.gz." .. ext, text)) == text)
+	T.Fail(ext .. ": decode fails for uncompressed data",
+		function() rbxmk.decodeFormat("txt." .. ext, text) end)
+
+	local model = Instance.new("Model")
+	Instance.new("Part", model)
+	local name = os.join(root, "model.rbxm." .. ext)
+	file.write(name, model)
+	T.Pass(ext .. ": write compresses by extension",
+		string.sub(rbxmk.decodeFormat("txt." .. ext, rbxmk.readSource("file", name)), 1, 8) == "<roblox!")
+	local result = file.read(name)
+	T.Pass(ext .. ": read decompresses by extension",
+		result.Name == "model" and result:GetChildren()[1].ClassName == "Model")
+
+	local plain = os.join(root, "notes." .. ext)
+	file.write(plain, "notes")
+	T.Pass(ext .. ": read without inner format returns bytes",
+		file.read(plain) == "notes")
+end
+
+T.Fail("unknown inner format", function() rbxmk.encodeFormat("unknown.gz", "") end)
+T.Fail("non-compression outer format", function() rbxmk.encodeFormat("txt.bin", "") end)
+
+os.remove(root, true)
@@ -0,0 +1,78 @@
+local desc = rbxmk.newDesc("RootDesc")
+
+local base = rbxmk.newDesc("ClassDesc")
+base.Name = "BasePart"
+base.Superclass = "<<<ROOT>>>"
+desc:AddClass(base)
+local part = rbxmk.newDesc("ClassDesc")
+part.Name = "Part"
+part.Superclass = "BasePart"
+desc:AddClass(part)
+
+local prop = rbxmk.newDesc("PropertyDesc")
+prop.Name = "Shape"
+prop.ValueType = rbxmk.newDesc("TypeDesc", "Enum", "PartType")
+part:AddMember(prop)
+local prop = rbxmk.newDesc("PropertyDesc")
+prop.Name = "end"
+prop.ValueType = rbxmk.newDesc("TypeDesc", "Primitive", "bool")
+part:AddMember(prop)
+local func = rbxmk.newDesc("FunctionDesc")
+func.Name = "BindToRenderStep"
+func:SetParameters({
+	rbxmk.newDesc("ParameterDesc", rbxmk.newDesc("TypeDesc", "Primitive", "string"), "name"),
+	rbxmk.newDesc("ParameterDesc", rbxmk.newDesc("TypeDesc", "Primitive", "int"), "priority"),
+	rbxmk.newDesc("ParameterDesc", rbxmk.newDesc("TypeDesc", "Primitive", "Function"), "function"),
+})
+func.ReturnType = rbxmk.newDesc("TypeDesc", "Primitive", "void")
+part:AddMember(func)
+local func = rbxmk.newDesc("FunctionDesc")
+func.Name = "Touch"
+func:SetParameters({
+	rbxmk.newDesc("ParameterDesc", rbxmk.newDesc("TypeDesc", "Class", "BasePart"), "other"),
+	rbxmk.newDesc("ParameterDesc", rbxmk.newDesc("TypeDesc", "Primitive", "Function"), "callback", "nil"),
+})
+func.ReturnType = rbxmk.newDesc("TypeDesc", "Class", "BasePart")
+part:AddMember(func)
+local event = rbxmk.newDesc("EventDesc")
+event.Name = "Touched"
+part:AddMember(event)
+
+local enum = rbxmk.newDesc("EnumDesc")
+enum.Name = "PartType"
+local item = rbxmk.newDesc("EnumItemDesc")
+item.Name = "Ball"
+enum:AddItem(item)
+desc:AddEnum(enum)
+
+local out = rbxmk.decodeFormat("txt", rbxmk.encodeFormat("d.luau", desc))
+local function has(s)
+	return string.find(out, s, 1, true) ~= nil
+end
+
+T.Pass("declares root class without superclass",
+	has("declare class BasePart\n"))
+T.Pass("declares class with superclass",
+	has("declare class Part extends BasePart\n"))
+T.Pass("declares superclass before subclass",
+	string.find(out, "class BasePart", 1, true) < string.find(out, "class Part ", 1, true))
+T.Pass("declares enum property",
+	has("\tShape: EnumPartType\n"))
+T.Pass("skips members that are keywords",
+	not has("\tend:"))
+T.Pass("renames parameters that are keywords",
+	has("\tfunction BindToRenderStep(self, name: string, priority: number, arg3: (...any) -> ...any): ()\n"))
+T.Pass("parenthesizes optional function types",
+	has("\tfunction Touch(self, other: BasePart?, callback: ((...any) -> ...any)?): BasePart?\n"))
+T.Pass("declares event",
+	has("\tTouched: RBXScriptSignal\n"))
+T.Pass("declares enum items",
+	has("declare class EnumPartType_INTERNAL extends Enum\n\tBall: EnumPartType\n"))
+T.Pass("declares enum list",
+	has("\tPartType: EnumPartType_INTERNAL,\n") and has("declare Enum: ENUM_LIST\n"))
+T.Pass("encodes empty descriptor",
+	rbxmk.decodeFormat("txt", rbxmk.encodeFormat("d.luau", rbxmk.newDesc("RootDesc"))) == "")
+T.Fail("cannot encode other types",
+	function() rbxmk.encodeFormat("d.luau", 42) end)
+T.Fail("cannot be decoded",
+	function() rbxmk.decodeFormat("d.luau", "") end)
@@ -0,0 +1,92 @@
+local desc = rbxmk.newDesc("RootDesc")
+
+local base = rbxmk.newDesc("ClassDesc")
+base.Name = "BasePart"
+base.Superclass = "<<<ROOT>>>"
+desc:AddClass(base)
+local part = rbxmk.newDesc("ClassDesc")
+part.Name = "Part"
+part.Superclass = "BasePart"
+desc:AddClass(part)
+
+local prop = rbxmk.newDesc("PropertyDesc")
+prop.Name = "Shape"
+prop.ValueType = rbxmk.newDesc("TypeDesc", "Enum", "PartType")
+part:AddMember(prop)
+local prop = rbxmk.newDesc("PropertyDesc")
+prop.Name = "Foo Bar"
+prop.ValueType = rbxmk.newDesc("TypeDesc", "Primitive", "bool")
+part:AddMember(prop)
+local func = rbxmk.newDesc("FunctionDesc")
+func.Name = "BindToRenderStep"
+func:SetParameters({
+	rbxmk.newDesc("ParameterDesc", rbxmk.newDesc("TypeDesc", "Primitive", "string"), "name"),
+	rbxmk.newDesc("ParameterDesc", rbxmk.newDesc("TypeDesc", "Primitive", "int"), "priority"),
+	rbxmk.newDesc("ParameterDesc", rbxmk.newDesc("TypeDesc", "Primitive", "Function"), "function"),
+})
+func.ReturnType = rbxmk.newDesc("TypeDesc", "Primitive", "void")
+part:AddMember(func)
+local func = rbxmk.newDesc("FunctionDesc")
+func.Name = "Touch"
+func:SetParameters({
+	rbxmk.newDesc("ParameterDesc", rbxmk.newDesc("TypeDesc", "Primitive", "string"), "name", "nil"),
+	rbxmk.newDesc("ParameterDesc", rbxmk.newDesc("TypeDesc", "Class", "BasePart"), "other"),
+	rbxmk.newDesc("ParameterDesc", rbxmk.newDesc("TypeDesc", "Primitive", "Function"), "callback", "nil"),
+})
+func.ReturnType = rbxmk.newDesc("TypeDesc", "Class", "BasePart")
+part:AddMember(func)
+local callback = rbxmk.newDesc("CallbackDesc")
+callback.Name = "OnInvoke"
+callback:SetParameters({
+	rbxmk.newDesc("ParameterDesc", rbxmk.newDesc("TypeDesc", "Group", "Tuple"), "arguments"),
+})
+callback.ReturnType = rbxmk.newDesc("TypeDesc", "Group", "Tuple")
+part:AddMember(callback)
+local event = rbxmk.newDesc("EventDesc")
+event.Name = "Touched"
+part:AddMember(event)
+
+local enum = rbxmk.newDesc("EnumDesc")
+enum.Name = "PartType"
+local item = rbxmk.newDesc("EnumItemDesc")
+item.Name = "Ball"
+enum:AddItem(item)
+local item = rbxmk.newDesc("EnumItemDesc")
+item.Name = "Block"
+item.Value = 1
+enum:AddItem(item)
+desc:AddEnum(enum)
+
+local out = rbxmk.decodeFormat("txt", rbxmk.encodeFormat("d.ts", desc))
+local function has(s)
+	return string.find(out, s, 1, true) ~= nil
+end
+
+T.Pass("declares root class without superclass",
+	has("interface BasePart {\n"))
+T.Pass("declares class with superclass",
+	has("interface Part extends BasePart {\n"))
+T.Pass("declares superclass before subclass",
+	string.find(out, "interface BasePart", 1, true) < string.find(out, "interface Part ", 1, true))
+T.Pass("declares enum property",
+	has("\tShape: Enum.PartType;\n"))
+T.Pass("quotes members that are not valid identifiers",
+	has('\t"Foo Bar": boolean;\n'))
+T.Pass("renames parameters that are keywords",
+	has("\tBindToRenderStep(this: Part, name: string, priority: number, arg3: Callback): void;\n"))
+T.Pass("marks only trailing optional parameters as optional",
+	has("\tTouch(this: Part, name: string | undefined, other: BasePart | undefined, callback?: Callback): BasePart | undefined;\n"))
+T.Pass("declares callback with tuples",
+	has("\tOnInvoke: (...args: Array<unknown>) => LuaTuple<Array<unknown>>;\n"))
+T.Pass("declares event",
+	has("\treadonly Touched: RBXScriptSignal;\n"))
+T.Pass("declares enum items",
+	has("\t\tinterface Ball extends EnumItem {\n\t\t\tName: \"Ball\";\n\t\t\tValue: 0;\n\t\t}\n\t\tconst Ball: Ball;\n"))
+T.Pass("declares enum type as union of items",
+	has("\ttype PartType = PartType.Ball | PartType.Block;\n"))
+T.Pass("encodes empty descriptor",
+	rbxmk.decodeFormat("txt", rbxmk.encodeFormat("d.ts", rbxmk.newDesc("RootDesc"))) == "")
+T.Fail("cannot encode other types",
+	function() rbxmk.encodeFormat("d.ts", 42) end)
+T.Fail("cannot be decoded",
+	function() rbxmk.decodeFormat("d.ts", "") end)
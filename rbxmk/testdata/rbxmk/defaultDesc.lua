@@ -0,0 +1,31 @@
+local desc = rbxmk.defaultDesc()
+T.Pass("defaultDesc returns a RootDesc",
+	typeof(desc) == "RootDesc")
+T.Pass("default descriptor has classes",
+	desc:Class("Part") ~= nil)
+T.Pass("default descriptor has enums",
+	desc:Enum("Material") ~= nil)
+T.Pass("default descriptor has enum types",
+	function() return desc:EnumTypes().Material.Plastic.Value == 256 end)
+T.Pass("default descriptor matches bundled dump",
+	function()
+		local file = file.read(os.expand("$sd/../dump.desc.json"))
+		return #rbxmk.diffDesc(file, desc) == 0
+	end)
+
+local other = rbxmk.defaultDesc()
+T.Pass("defaultDesc returns a new descriptor each call",
+	other ~= desc)
+T.Pass("default descriptors are independent",
+	function()
+		desc:RemoveClass("Part")
+		return desc:Class("Part") == nil and other:Class("Part") ~= nil and rbxmk.defaultDesc():Class("Part") ~= nil
+	end)
+
+T.Pass("default descriptor can be used as global descriptor",
+	function()
+		rbxmk.globalDesc = rbxmk.defaultDesc()
+		local ok = pcall(Instance.new, "Foobar")
+		rbxmk.globalDesc = nil
+		return not ok
+	end)
@@ -0,0 +1,64 @@
+local desc = file.read(os.expand("$sd/../dump.desc.json"))
+
+T.Pass("descriptor has no defaults initially",
+	function() return next(desc:Defaults("Part")) == nil end)
+
+local defaults = DataModel.new()
+local instance = Instance.new("Instance", defaults)
+instance.Archivable = true
+local basePart = Instance.new("BasePart", defaults)
+basePart.Anchored = false
+basePart.Locked = true
+local part = Instance.new("Part", defaults)
+part.Name = "Part"
+part.Anchored = true
+part.Parent = defaults
+
+T.Pass("SetDefaults accepts a DataModel",
+	function() desc:SetDefaults(defaults) end)
+T.Pass("SetDefaults accepts an Instance",
+	function() desc:SetDefaults(part) end)
+T.Pass("SetDefaults accepts Objects",
+	function() desc:SetDefaults(defaults:GetChildren()) end)
+T.Fail("SetDefaults expects an Instance, Objects, or nil",
+	function() desc:SetDefaults("foobar") end)
+
+local props = desc:Defaults("Part")
+T.Pass("Defaults includes defaults of class",
+	props.Name == "Part" and props.Anchored == true)
+T.Pass("Defaults includes defaults of superclasses",
+	props.Locked == true and props.Archivable == true)
+T.Pass("Defaults excludes instance references",
+	props.Parent == nil)
+T.Pass("Defaults of unknown class is empty",
+	function() return next(desc:Defaults("Foobar")) == nil end)
+
+local new = Instance.new("Part", nil, desc)
+T.Pass("Instance.new applies defaults",
+	new.Name == "Part" and new.Anchored == true and new.Locked == true)
+T.Pass("defaults are copied",
+	function()
+		new.Anchored = false
+		return Instance.new("Part", nil, desc).Anchored == true
+	end)
+T.Pass("GetProperties prefers defaults over zero values",
+	function()
+		local props = Instance.new("Part", nil, desc):GetProperties(true)
+		return props.Archivable == true and props.Locked == true and props.Size == Vector3.new(0, 0, 0)
+	end)
+
+local game = DataModel.new()
+game[sym.Desc] = desc
+T.Pass("GetService applies defaults",
+	function()
+		local workspace = Instance.new("Workspace", nil, false)
+		workspace.Archivable = true
+		desc:SetDefaults(workspace)
+		return game:GetService("Workspace").Archivable == true
+	end)
+
+T.Pass("SetDefaults with nil clears defaults",
+	function()
+		desc:SetDefaults(nil)
+		return next(desc:Defaults("Part")) == nil and Instance.new("Part", nil, desc):GetProperties().Anchored == nil
+	end)
@@ -0,0 +1,88 @@
+local desc = rbxmk.newDesc("RootDesc")
+
+local instance = rbxmk.newDesc("ClassDesc")
+instance.Name = "Instance"
+instance:SetTag("NotCreatable")
+local prop = rbxmk.newDesc("PropertyDesc")
+prop.Name = "Parent"
+prop.ValueType = rbxmk.newDesc("TypeDesc", "Class", "Instance")
+prop.ReadSecurity = "None"
+prop.WriteSecurity = "PluginSecurity"
+instance:AddMember(prop)
+local func = rbxmk.newDesc("FunctionDesc")
+func.Name = "Remove"
+func.Security = "None"
+func.ReturnType = rbxmk.newDesc("TypeDesc", "Primitive", "void")
+func:SetTag("Deprecated")
+instance:AddMember(func)
+local func = rbxmk.newDesc("FunctionDesc")
+func.Name = "Find"
+func.Security = "None"
+func:SetParameters({rbxmk.newDesc("ParameterDesc", rbxmk.newDesc("TypeDesc", "Primitive", "string|number"), "key")})
+func.ReturnType = rbxmk.newDesc("TypeDesc", "Primitive", "bool|nil")
+instance:AddMember(func)
+desc:AddClass(instance)
+
+local part = rbxmk.newDesc("ClassDesc")
+part.Name = "Part"
+part.Superclass = "Instance"
+local prop = rbxmk.newDesc("PropertyDesc")
+prop.Name = "Shape"
+prop.ValueType = rbxmk.newDesc("TypeDesc", "Enum", "PartType")
+part:AddMember(prop)
+desc:AddClass(part)
+
+local enum = rbxmk.newDesc("EnumDesc")
+enum.Name = "PartType"
+local item = rbxmk.newDesc("EnumItemDesc")
+item.Name = "Ball"
+item.Value = 0
+enum:AddItem(item)
+desc:AddEnum(enum)
+
+local md = rbxmk.decodeFormat("txt", rbxmk.encodeFormat("desc.md", desc))
+local function has(s)
+	return string.find(md, s, 1, true) ~= nil
+end
+T.Pass("desc.md lists classes",
+	has("- [Instance](#class-instance)\n"))
+T.Pass("desc.md lists enums",
+	has("- [PartType](#enum-parttype)\n"))
+T.Pass("desc.md has a section for each class",
+	has("## Class Instance\n") and has("## Class Part\n"))
+T.Pass("desc.md links superclass",
+	has("Superclass: [Instance](#class-instance)\n"))
+T.Pass("desc.md links subclasses",
+	has("Subclasses: [Part](#class-part)\n"))
+T.Pass("desc.md shows class tags",
+	has("Tags: NotCreatable\n"))
+T.Pass("desc.md shows property with linked type and security",
+	has("`Parent` | Property | [`Instance`](#class-instance) | Write: PluginSecurity |\n"))
+T.Pass("desc.md shows function with tags",
+	has("`Remove()` | Function | `void` |  | Deprecated\n"))
+T.Pass("desc.md escapes pipes in code spans within tables",
+	has("`Find(key: string\\|number)` | Function | `bool\\|nil` |"))
+T.Pass("desc.md links enum types",
+	has("[`PartType`](#enum-parttype)"))
+T.Pass("desc.md has a section for each enum",
+	has("## Enum PartType\n") and has("`Ball` | 0 |\n"))
+
+local doc = rbxmk.decodeFormat("txt", rbxmk.encodeFormat("desc.html", desc))
+local function has(s)
+	return string.find(doc, s, 1, true) ~= nil
+end
+T.Pass("desc.html is a complete document",
+	has("<html>") and has("</html>"))
+T.Pass("desc.html has anchored section for each class",
+	has('<h2 id="class-instance">Class Instance</h2>'))
+T.Pass("desc.html has anchored section for each enum",
+	has('<h2 id="enum-parttype">Enum PartType</h2>'))
+T.Pass("desc.html links superclass",
+	has('Superclass: <a href="#class-instance">Instance</a>'))
+T.Pass("desc.html shows members",
+	has('<tr><td><code>Remove()</code></td><td>Function</td><td><code>void</code></td><td></td><td>Deprecated</td></tr>'))
+
+T.Fail("desc.md cannot encode other types",
+	function() rbxmk.encodeFormat("desc.md", 42) end)
+T.Fail("desc.html cannot be decoded",
+	function() rbxmk.decodeFormat("desc.html", "") end)
@@ -0,0 +1,64 @@
+local prev = rbxmk.newDesc("RootDesc")
+local next = rbxmk.newDesc("RootDesc")
+
+local class = rbxmk.newDesc("ClassDesc")
+class.Name = "Part"
+local prop = rbxmk.newDesc("PropertyDesc")
+prop.Name = "Size"
+prop.ReadSecurity = "None"
+prop.WriteSecurity = "PluginSecurity"
+class:AddMember(prop)
+local func = rbxmk.newDesc("FunctionDesc")
+func.Name = "Resize"
+func.Security = "None"
+class:AddMember(func)
+next:AddClass(class)
+
+local enum = rbxmk.newDesc("EnumDesc")
+enum.Name = "Material"
+local item = rbxmk.newDesc("EnumItemDesc")
+item.Name = "Plastic"
+item.Value = 256
+enum:AddItem(item)
+prev:AddEnum(enum)
+
+local actions = rbxmk.diffDesc(prev, next)
+
+local md = rbxmk.decodeFormat("txt", rbxmk.encodeFormat("desc-patch.md", actions))
+T.Pass("desc-patch.md has a section for classes",
+	function() return string.find(md, "# Classes", 1, true) ~= nil end)
+T.Pass("desc-patch.md has a group for each class",
+	function() return string.find(md, "## Part", 1, true) ~= nil end)
+T.Pass("desc-patch.md shows added property with type and security",
+	function() return string.find(md, "- Added property `Size: ", 1, true) ~= nil
+		and string.find(md, "WriteSecurity: PluginSecurity", 1, true) ~= nil end)
+T.Pass("desc-patch.md shows added function",
+	function() return string.find(md, "- Added function `Resize()", 1, true) ~= nil end)
+T.Pass("desc-patch.md lists classes before enums",
+	function() return string.find(md, "# Classes", 1, true) < string.find(md, "# Enums", 1, true) end)
+T.Pass("desc-patch.md shows removed enum",
+	function() return string.find(md, "- Removed enum `Material`", 1, true) ~= nil end)
+
+local txt = rbxmk.decodeFormat("txt", rbxmk.encodeFormat("desc-patch.txt", actions))
+T.Pass("desc-patch.txt indents groups",
+	function() return string.find(txt, "\n\tPart\n", 1, true) ~= nil end)
+T.Pass("desc-patch.txt indents actions",
+	function() return string.find(txt, "\t\tAdded function Resize()", 1, true) ~= nil end)
+
+local json = [[
+[
+	{"Type": 1, "Element": "Class", "Primary": "Part", "Fields": {"Tags": ["NotCreatable"]}},
+	{"Type": 1, "Element": "Property", "Primary": "Part", "Secondary": "Size", "Fields": {"Tags": ["Deprecated"]}}
+]
+]]
+local md = rbxmk.decodeFormat("txt", rbxmk.encodeFormat("desc-patch.md", rbxmk.decodeFormat("desc-patch.json", json)))
+T.Pass("desc-patch.md shows tags of actions decoded from desc-patch.json",
+	function() return string.find(md, "Tags: [NotCreatable]", 1, true) ~= nil
+		and string.find(md, "Tags: [Deprecated]", 1, true) ~= nil end)
+
+T.Pass("report of no actions is empty",
+	function() return rbxmk.decodeFormat("txt", rbxmk.encodeFormat("desc-patch.md", rbxmk.diffDesc(nil, nil))) == "" end)
+T.Fail("desc-patch.md cannot be decoded",
+	function() rbxmk.decodeFormat("desc-patch.md", "") end)
+T.Fail("desc-patch.md cannot encode other types",
+	function() rbxmk.encodeFormat("desc-patch.md", 42) end)
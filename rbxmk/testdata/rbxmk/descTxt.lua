@@ -0,0 +1,140 @@
+local desc = rbxmk.newDesc("RootDesc")
+
+local pv = rbxmk.newDesc("ClassDesc")
+pv.Name = "PVInstance"
+pv.Superclass = "<<<ROOT>>>"
+pv.MemoryCategory = "Instances"
+pv:SetTag("NotCreatable")
+desc:AddClass(pv)
+local model = rbxmk.newDesc("ClassDesc")
+model.Name = "Model"
+model.Superclass = "PVInstance"
+desc:AddClass(model)
+
+local prop = rbxmk.newDesc("PropertyDesc")
+prop.Name = "Locked"
+prop.ValueType = rbxmk.newDesc("TypeDesc", "Primitive", "bool")
+prop.ReadSecurity = "None"
+prop.WriteSecurity = "PluginSecurity"
+prop.CanLoad = true
+prop.CanSave = true
+pv:AddMember(prop)
+local prop = rbxmk.newDesc("PropertyDesc")
+prop.Name = "Origin"
+prop.ValueType = rbxmk.newDesc("TypeDesc", "DataType", "CFrame")
+prop.ReadSecurity = "None"
+prop.WriteSecurity = "None"
+pv:AddMember(prop)
+local func = rbxmk.newDesc("FunctionDesc")
+func.Name = "PivotTo"
+func:SetParameters({
+	rbxmk.newDesc("ParameterDesc", rbxmk.newDesc("TypeDesc", "DataType", "CFrame"), "targetCFrame"),
+	rbxmk.newDesc("ParameterDesc", rbxmk.newDesc("TypeDesc", "Primitive", "bool"), "force", "false"),
+})
+func.ReturnType = rbxmk.newDesc("TypeDesc", "Primitive", "void")
+func.Security = "PluginSecurity"
+pv:AddMember(func)
+
+local prop = rbxmk.newDesc("PropertyDesc")
+prop.Name = "PrimaryPart"
+prop.ValueType = rbxmk.newDesc("TypeDesc", "Class", "PVInstance")
+prop.ReadSecurity = "None"
+prop.WriteSecurity = "None"
+model:AddMember(prop)
+local prop = rbxmk.newDesc("PropertyDesc")
+prop.Name = "LevelOfDetail"
+prop.ValueType = rbxmk.newDesc("TypeDesc", "Enum", "ModelLevelOfDetail")
+prop.ReadSecurity = "None"
+prop.WriteSecurity = "None"
+model:AddMember(prop)
+local prop = rbxmk.newDesc("PropertyDesc")
+prop.Name = "Bad.Name"
+prop.ValueType = rbxmk.newDesc("TypeDesc", "Primitive", "bool")
+model:AddMember(prop)
+local event = rbxmk.newDesc("EventDesc")
+event.Name = "Moved"
+event:SetParameters({
+	rbxmk.newDesc("ParameterDesc", rbxmk.newDesc("TypeDesc", "Primitive", "string"), "reason"),
+})
+event.Security = "None"
+model:AddMember(event)
+
+local enum = rbxmk.newDesc("EnumDesc")
+enum.Name = "ModelLevelOfDetail"
+local item = rbxmk.newDesc("EnumItemDesc")
+item.Name = "Automatic"
+item.Value = 0
+enum:AddItem(item)
+local item = rbxmk.newDesc("EnumItemDesc")
+item.Name = "StreamingMesh"
+item.Value = 1
+enum:AddItem(item)
+desc:AddEnum(enum)
+
+local out = rbxmk.decodeFormat("txt", rbxmk.encodeFormat("desc.txt", desc))
+local function has(s)
+	return string.find(out, s, 1, true) ~= nil
+end
+
+T.Pass("encodes root class",
+	has("Class PVInstance : <<<ROOT>>> [NotCreatable]\n"))
+T.Pass("encodes class with superclass",
+	has("Class Model : PVInstance\n"))
+T.Pass("encodes superclass before subclass",
+	string.find(out, "Class PVInstance", 1, true) < string.find(out, "Class Model ", 1, true))
+T.Pass("encodes property",
+	has("\tProperty PVInstance Model.PrimaryPart\n"))
+T.Pass("encodes write security",
+	has("\tProperty bool PVInstance.Locked [ScriptWriteRestricted: [PluginSecurity]]\n"))
+T.Pass("encodes function with security",
+	has("\tFunction void PVInstance:PivotTo(CFrame targetCFrame, bool force = false) [PluginSecurity]\n"))
+T.Pass("encodes event",
+	has("\tEvent Model.Moved(string reason)\n"))
+T.Pass("omits members that cannot be represented",
+	not has("Bad.Name"))
+T.Pass("encodes enum items",
+	has("Enum ModelLevelOfDetail\n\tEnumItem ModelLevelOfDetail.Automatic : 0\n\tEnumItem ModelLevelOfDetail.StreamingMesh : 1\n"))
+
+local decoded = rbxmk.decodeFormat("desc.txt", out)
+local pv = decoded:Class("PVInstance")
+local model = decoded:Class("Model")
+T.Pass("decodes classes",
+	pv ~= nil and model.Superclass == "PVInstance")
+T.Pass("decodes class tags",
+	pv:Tag("NotCreatable"))
+T.Pass("does not decode memory category",
+	pv.MemoryCategory == "")
+T.Pass("decodes class type category",
+	model:Member("PrimaryPart").ValueType.Category == "Class")
+T.Pass("decodes primitive type category",
+	pv:Member("Locked").ValueType.Category == "Primitive")
+T.Pass("decodes enum type category",
+	model:Member("LevelOfDetail").ValueType.Category == "Enum")
+T.Pass("decodes data type category",
+	pv:Member("Origin").ValueType.Category == "DataType")
+T.Pass("decodes read security",
+	pv:Member("Locked").ReadSecurity == "None")
+T.Pass("decodes write security",
+	pv:Member("Locked").WriteSecurity == "PluginSecurity")
+T.Pass("removes security tags",
+	#pv:Member("Locked"):Tags() == 0 and #pv:Member("PivotTo"):Tags() == 0)
+T.Pass("decodes function security",
+	pv:Member("PivotTo").Security == "PluginSecurity")
+T.Pass("decodes parameters",
+	pv:Member("PivotTo"):Parameters()[2].Default == "false")
+T.Pass("decodes properties as loadable and saveable",
+	pv:Member("Locked").CanLoad and pv:Member("Locked").CanSave)
+T.Pass("decoded descriptor keeps properties when normalizing",
+	function()
+		local inst = Instance.new("Model")
+		inst.Locked = true
+		rbxmk.normalize(inst, decoded)
+		return inst.Locked == true
+	end)
+T.Pass("decodes enums",
+	decoded:Enum("ModelLevelOfDetail"):Item("StreamingMesh").Value == 1)
+
+T.Pass("encodes empty descriptor",
+	rbxmk.decodeFormat("txt", rbxmk.encodeFormat("desc.txt", rbxmk.newDesc("RootDesc"))) == "")
+T.Fail("cannot encode other types",
+	function() rbxmk.encodeFormat("desc.txt", 42) end)
@@ -0,0 +1,124 @@
+local function newDesc(classes, enums)
+	local desc = rbxmk.newDesc("RootDesc")
+	for name, class in pairs(classes) do
+		local classDesc = rbxmk.newDesc("ClassDesc")
+		classDesc.Name = name
+		classDesc.Superclass = class.Superclass or ""
+		classDesc:SetTag(unpack(class.Tags or {}))
+		for name, security in pairs(class.Members or {}) do
+			local prop = rbxmk.newDesc("PropertyDesc")
+			prop.Name = name
+			prop.ReadSecurity = security
+			classDesc:AddMember(prop)
+		end
+		desc:AddClass(classDesc)
+	end
+	for name, items in pairs(enums or {}) do
+		local enumDesc = rbxmk.newDesc("EnumDesc")
+		enumDesc.Name = name
+		for name, value in pairs(items) do
+			local item = rbxmk.newDesc("EnumItemDesc")
+			item.Name = name
+			item.Value = value
+			enumDesc:AddItem(item)
+		end
+		desc:AddEnum(enumDesc)
+	end
+	return desc
+end
+
+local base = newDesc({
+	Base = {Members = {A = "None", B = "None"}, Tags = {"BaseTag"}},
+	Shared = {Superclass = "Base"},
+}, {
+	Enum = {Item = 0, BaseItem = 1},
+})
+local overlay = newDesc({
+	Base = {Members = {B = "Plugin", C = "None"}, Tags = {"OverlayTag"}},
+	Custom = {Superclass = "Base"},
+}, {
+	Enum = {Item = 2, OverlayItem = 3},
+	Custom = {Item = 0},
+})
+
+local merged = rbxmk.mergeDesc(base, overlay)
+T.Pass("mergeDesc returns a new descriptor",
+	merged ~= base and merged ~= overlay)
+T.Pass("merged descriptor has classes of both descriptors",
+	merged:Class("Shared") ~= nil and merged:Class("Custom") ~= nil)
+T.Pass("merged class has members of both descriptors",
+	function()
+		local class = merged:Class("Base")
+		return class:Member("A") ~= nil and class:Member("B") ~= nil and class:Member("C") ~= nil
+	end)
+T.Pass("merged class has tags of both descriptors",
+	function()
+		local class = merged:Class("Base")
+		return class:Tag("BaseTag") and class:Tag("OverlayTag")
+	end)
+T.Pass("merged descriptor has enums of both descriptors",
+	merged:Enum("Custom") ~= nil)
+T.Pass("merged enum has items of both descriptors",
+	function()
+		local enum = merged:Enum("Enum")
+		return enum:Item("BaseItem") ~= nil and enum:Item("OverlayItem") ~= nil
+	end)
+T.Pass("overlay wins conflicts by default",
+	merged:Class("Base"):Member("B").ReadSecurity == "Plugin" and merged:Enum("Enum"):Item("Item").Value == 2)
+T.Pass("base is not modified",
+	base:Class("Custom") == nil and base:Class("Base"):Member("B").ReadSecurity == "None")
+T.Pass("overlay is not modified",
+	overlay:Class("Shared") == nil and overlay:Class("Base"):Member("A") == nil)
+T.Pass("merged elements are copies",
+	function()
+		merged:Class("Shared").Superclass = "Foobar"
+		return base:Class("Shared").Superclass == "Base"
+	end)
+
+T.Pass("overlay conflict option uses overlay",
+	rbxmk.mergeDesc(base, overlay, {conflict = "overlay"}):Class("Base"):Member("B").ReadSecurity == "Plugin")
+T.Pass("base conflict option keeps base",
+	function()
+		local merged = rbxmk.mergeDesc(base, overlay, {conflict = "base"})
+		return merged:Class("Base"):Member("B").ReadSecurity == "None" and
+			merged:Enum("Enum"):Item("Item").Value == 0 and
+			merged:Class("Base"):Member("C") ~= nil
+	end)
+T.Fail("error conflict option throws on conflict",
+	function() rbxmk.mergeDesc(base, overlay, {conflict = "error"}) end)
+T.Pass("error conflict option succeeds without conflicts",
+	function()
+		local merged = rbxmk.mergeDesc(base, newDesc({Custom = {}}), {conflict = "error"})
+		return merged:Class("Custom") ~= nil
+	end)
+local function withDefaults(props)
+	local desc = newDesc({Part = {}})
+	local part = Instance.new("Part")
+	for name, value in pairs(props) do
+		part[name] = value
+	end
+	desc:SetDefaults(part)
+	return desc
+end
+local defaultsBase = withDefaults({Anchored = false, Locked = true})
+local defaultsOverlay = withDefaults({Anchored = true, Transparency = 0.5})
+T.Pass("mergeDesc combines defaults",
+	function()
+		local props = rbxmk.mergeDesc(defaultsBase, defaultsOverlay):Defaults("Part")
+		return props.Locked == true and props.Transparency == 0.5
+	end)
+T.Pass("overlay conflict option uses defaults of overlay",
+	rbxmk.mergeDesc(defaultsBase, defaultsOverlay):Defaults("Part").Anchored == true)
+T.Pass("base conflict option keeps defaults of base",
+	rbxmk.mergeDesc(defaultsBase, defaultsOverlay, {conflict = "base"}):Defaults("Part").Anchored == false)
+T.Fail("error conflict option throws on conflicting defaults",
+	function() rbxmk.mergeDesc(defaultsBase, defaultsOverlay, {conflict = "error"}) end)
+T.Pass("mergeDesc keeps defaults of one descriptor",
+	rbxmk.mergeDesc(newDesc({Part = {}}), defaultsBase):Defaults("Part").Locked == true)
+
+T.Fail("unknown conflict option throws",
+	function() rbxmk.mergeDesc(base, overlay, {conflict = "foobar"}) end)
+T.Fail("options must be a table",
+	function() rbxmk.mergeDesc(base, overlay, "overlay") end)
+T.Fail("mergeDesc requires two descriptors",
+	function() rbxmk.mergeDesc(base) end)
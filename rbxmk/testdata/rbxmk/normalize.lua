@@ -0,0 +1,64 @@
+local desc = file.read(os.expand("$sd/../dump.desc.json"))
+
+local function newPart()
+	local part = Instance.new("Part")
+	part.Name = "Part"
+	part.Transparency = 0.5
+	part.Material = 256
+	part.Size = Vector3.new(1, 2, 3)
+	return part
+end
+
+local part = newPart()
+T.Pass("unnormalized part has problems",
+	function() return #rbxmk.validate(part, desc) > 0 end)
+T.Fail("normalize requires a descriptor",
+	function() rbxmk.normalize(part) end)
+T.Pass("normalize accepts a descriptor",
+	function() rbxmk.normalize(part, desc) end)
+T.Pass("normalize removes unsaveable properties",
+	part.Size == nil)
+T.Pass("normalize converts values to declared types",
+	#rbxmk.validate(part, desc) == 0)
+T.Pass("normalize retains undescribed properties",
+	function()
+		local part = Instance.new("Part")
+		part.Foobar = true
+		rbxmk.normalize(part, desc)
+		return part.Foobar == true
+	end)
+
+local part = newPart()
+local b = rbxmk.encodeFormat("rbxmx", part)
+T.Pass("encoding does not normalize by default",
+	rbxmk.decodeFormat("rbxmx", b):GetChildren()[1].Size ~= nil)
+
+local part = newPart()
+local b = rbxmk.encodeFormat("rbxmx", part, {normalize = true, desc = desc})
+local decoded = rbxmk.decodeFormat("rbxmx", b):GetChildren()[1]
+T.Pass("normalize option removes unsaveable properties",
+	decoded.Size == nil)
+T.Pass("normalize option converts values to declared types",
+	#rbxmk.validate(decoded, desc) == 0)
+T.Pass("normalize option does not modify value",
+	part.Size ~= nil)
+
+T.Pass("normalize option without descriptor has no effect",
+	function()
+		local b = rbxmk.encodeFormat("rbxmx", newPart(), {normalize = true})
+		return rbxmk.decodeFormat("rbxmx", b):GetChildren()[1].Size ~= nil
+	end)
+T.Pass("normalize option uses global descriptor",
+	function()
+		local part = newPart()
+		rbxmk.globalDesc = desc
+		local b = rbxmk.encodeFormat("rbxmx", part, {normalize = true})
+		rbxmk.globalDesc = nil
+		return rbxmk.decodeFormat("rbxmx", b):GetChildren()[1].Size == nil
+	end)
+T.Fail("format options must be a table",
+	function() rbxmk.encodeFormat("rbxmx", newPart(), true) end)
+T.Fail("normalize option must be a bool",
+	function() rbxmk.encodeFormat("rbxmx", newPart(), {normalize = 1}) end)
+T.Fail("desc option must be a RootDesc",
+	function() rbxmk.encodeFormat("rbxmx", newPart(), {desc = 1}) end)
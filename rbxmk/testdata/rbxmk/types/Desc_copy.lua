@@ -0,0 +1,116 @@
+local function newDesc()
+	local desc = rbxmk.newDesc("RootDesc")
+	local class = rbxmk.newDesc("ClassDesc")
+	class.Name = "Part"
+	class:SetTag("A", "B")
+	local prop = rbxmk.newDesc("PropertyDesc")
+	prop.Name = "Size"
+	prop.ValueType = rbxmk.newDesc("TypeDesc", "DataType", "Vector3")
+	class:AddMember(prop)
+	local func = rbxmk.newDesc("FunctionDesc")
+	func.Name = "Resize"
+	func:SetParameters({rbxmk.newDesc("ParameterDesc", rbxmk.newDesc("TypeDesc", "Primitive", "int"), "n")})
+	class:AddMember(func)
+	desc:AddClass(class)
+	local enum = rbxmk.newDesc("EnumDesc")
+	enum.Name = "Material"
+	local item = rbxmk.newDesc("EnumItemDesc")
+	item.Name = "Plastic"
+	item.Value = 256
+	enum:AddItem(item)
+	desc:AddEnum(enum)
+	return desc
+end
+
+local desc = newDesc()
+local copy = desc:Copy()
+T.Pass("RootDesc:Copy returns a distinct RootDesc",
+	typeof(copy) == "RootDesc" and copy ~= desc)
+T.Pass("RootDesc:Copy is equal to the original",
+	desc:Equal(copy) and copy:Equal(desc))
+T.Pass("RootDesc:Equal compares structurally",
+	desc:Equal(newDesc()))
+copy:Class("Part"):Member("Size").ReadSecurity = "PluginSecurity"
+T.Pass("modifying copy does not modify original",
+	desc:Class("Part"):Member("Size").ReadSecurity ~= "PluginSecurity")
+
+local part = Instance.new("Part")
+part.Size = Vector3.new(1, 2, 3)
+desc:SetDefaults(part)
+local defaultsCopy = desc:Copy()
+T.Pass("RootDesc:Copy copies defaults",
+	defaultsCopy:Defaults("Part").Size == Vector3.new(1, 2, 3))
+defaultsCopy:SetDefaults(nil)
+T.Pass("modifying defaults of copy does not modify original",
+	desc:Defaults("Part").Size == Vector3.new(1, 2, 3))
+T.Pass("RootDesc:Equal detects modified members",
+	not desc:Equal(copy))
+local other = newDesc()
+other:RemoveEnum("Material")
+T.Pass("RootDesc:Equal detects removed enums",
+	not desc:Equal(other))
+local other = newDesc()
+other:Class("Part"):SetTag("C")
+T.Pass("RootDesc:Equal detects modified tags",
+	not desc:Equal(other))
+local other = newDesc()
+other:Class("Part"):UnsetTag("A", "B")
+other:Class("Part"):SetTag("B", "A")
+T.Pass("RootDesc:Equal ignores tag order",
+	desc:Equal(other))
+
+local class = desc:Class("Part")
+local classCopy = class:Copy()
+T.Pass("ClassDesc:Copy returns an equal ClassDesc",
+	classCopy ~= class and class:Equal(classCopy))
+classCopy:RemoveMember("Size")
+T.Pass("ClassDesc:Copy copies members",
+	class:Member("Size") ~= nil and not class:Equal(classCopy))
+
+local prop = class:Member("Size")
+local propCopy = prop:Copy()
+T.Pass("PropertyDesc:Copy returns an equal PropertyDesc",
+	propCopy ~= prop and prop:Equal(propCopy))
+propCopy.Name = "Other"
+T.Pass("PropertyDesc:Equal detects changes",
+	not prop:Equal(propCopy) and prop.Name == "Size")
+
+local func = class:Member("Resize")
+T.Pass("FunctionDesc:Copy returns an equal FunctionDesc",
+	func:Copy() ~= func and func:Equal(func:Copy()))
+local funcCopy = func:Copy()
+funcCopy:SetParameters({})
+T.Pass("FunctionDesc:Equal compares parameters",
+	not func:Equal(funcCopy))
+
+local event = rbxmk.newDesc("EventDesc")
+T.Pass("EventDesc:Copy returns an equal EventDesc",
+	event:Copy() ~= event and event:Equal(event:Copy()))
+local callback = rbxmk.newDesc("CallbackDesc")
+T.Pass("CallbackDesc:Copy returns an equal CallbackDesc",
+	callback:Copy() ~= callback and callback:Equal(callback:Copy()))
+
+local param = func:Parameters()[1]
+T.Pass("ParameterDesc:Copy returns an equal ParameterDesc",
+	param:Equal(param:Copy()))
+T.Pass("ParameterDesc:Equal detects changes",
+	not param:Equal(rbxmk.newDesc("ParameterDesc", nil, "n")))
+local typ = prop.ValueType
+T.Pass("TypeDesc:Copy returns an equal TypeDesc",
+	typ:Equal(typ:Copy()))
+T.Pass("TypeDesc:Equal detects changes",
+	not typ:Equal(rbxmk.newDesc("TypeDesc", "DataType", "Vector2")))
+
+local enum = desc:Enum("Material")
+local enumCopy = enum:Copy()
+T.Pass("EnumDesc:Copy returns an equal EnumDesc",
+	enumCopy ~= enum and enum:Equal(enumCopy))
+enumCopy:Item("Plastic").Value = 1
+T.Pass("EnumDesc:Copy copies items",
+	enum:Item("Plastic").Value == 256 and not enum:Equal(enumCopy))
+local item = enum:Item("Plastic")
+T.Pass("EnumItemDesc:Copy returns an equal EnumItemDesc",
+	item:Copy() ~= item and item:Equal(item:Copy()))
+
+T.Fail("Equal expects the same type",
+	function() prop:Equal(func) end)
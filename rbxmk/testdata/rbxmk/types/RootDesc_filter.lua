@@ -0,0 +1,131 @@
+local desc = rbxmk.newDesc("RootDesc")
+
+local class = rbxmk.newDesc("ClassDesc")
+class.Name = "Part"
+desc:AddClass(class)
+local function addProp(name, read, write, ...)
+	local prop = rbxmk.newDesc("PropertyDesc")
+	prop.Name = name
+	prop.ReadSecurity = read
+	prop.WriteSecurity = write
+	prop:SetTag(...)
+	class:AddMember(prop)
+end
+addProp("Public", "None", "None")
+addProp("Plugin", "PluginSecurity", "PluginSecurity")
+addProp("Internal", "RobloxScriptSecurity", "RobloxScriptSecurity")
+addProp("Old", "None", "None", "Deprecated")
+addProp("ReadOnly", "None", "RobloxScriptSecurity")
+local func = rbxmk.newDesc("FunctionDesc")
+func.Name = "Method"
+func.Security = "RobloxSecurity"
+class:AddMember(func)
+
+local hidden = rbxmk.newDesc("ClassDesc")
+hidden.Name = "Hidden"
+hidden:SetTag("Hidden")
+desc:AddClass(hidden)
+
+local enum = rbxmk.newDesc("EnumDesc")
+enum.Name = "Material"
+local function addItem(name, ...)
+	local item = rbxmk.newDesc("EnumItemDesc")
+	item.Name = name
+	item:SetTag(...)
+	enum:AddItem(item)
+end
+addItem("Plastic")
+addItem("Legacy", "Deprecated")
+desc:AddEnum(enum)
+
+local filtered = desc:Filter({security = {"None", "PluginSecurity"}})
+T.Pass("Filter returns a new RootDesc",
+	typeof(filtered) == "RootDesc" and filtered ~= desc)
+T.Pass("Filter by security keeps allowed members",
+	filtered:Class("Part"):Member("Public") ~= nil and filtered:Class("Part"):Member("Plugin") ~= nil)
+T.Pass("Filter by security excludes other members",
+	filtered:Class("Part"):Member("Internal") == nil and filtered:Class("Part"):Member("Method") == nil)
+T.Pass("Filter by security checks write security of properties",
+	filtered:Class("Part"):Member("ReadOnly") == nil)
+T.Pass("Filter by security keeps classes and enums",
+	filtered:Class("Hidden") ~= nil and filtered:Enum("Material") ~= nil)
+T.Pass("Filter does not modify original",
+	desc:Class("Part"):Member("Internal") ~= nil)
+
+local filtered = desc:Filter({excludeTags = {"Deprecated", "Hidden"}})
+T.Pass("Filter by tags excludes tagged members",
+	filtered:Class("Part"):Member("Old") == nil and filtered:Class("Part"):Member("Internal") ~= nil)
+T.Pass("Filter by tags excludes tagged classes",
+	filtered:Class("Hidden") == nil)
+T.Pass("Filter by tags excludes tagged enum items",
+	filtered:Enum("Material"):Item("Legacy") == nil and filtered:Enum("Material"):Item("Plastic") ~= nil)
+
+local filtered = desc:Filter({security = {"None"}, excludeTags = {"Deprecated"}})
+T.Pass("Filter combines security and tags",
+	#filtered:Class("Part"):Members() == 1)
+
+local filtered = desc:Filter({})
+T.Pass("Filter with empty options keeps everything",
+	#filtered:Class("Part"):Members() == 6 and filtered:Class("Hidden") ~= nil)
+
+local parents = {}
+local filtered = desc:Filter(function(element, parent)
+	if typeof(element) == "PropertyDesc" then
+		parents[element.Name] = parent and parent.Name
+	end
+	return typeof(element) ~= "FunctionDesc" and element.Name ~= "Material"
+end)
+T.Pass("Filter predicate receives parent of members",
+	parents.Public == "Part")
+T.Pass("Filter predicate excludes members",
+	filtered:Class("Part"):Member("Method") == nil)
+T.Pass("Filter predicate excludes enums",
+	filtered:Enum("Material") == nil)
+
+local chain = rbxmk.newDesc("RootDesc")
+local function addClass(name, superclass, ...)
+	local class = rbxmk.newDesc("ClassDesc")
+	class.Name = name
+	class.Superclass = superclass
+	class:SetTag(...)
+	chain:AddClass(class)
+end
+addClass("Base", "<<<ROOT>>>")
+addClass("Middle", "Base", "Hidden")
+addClass("Leaf", "Middle")
+local defaults = DataModel.new()
+Instance.new("Base", defaults).BaseValue = 1
+Instance.new("Middle", defaults).MiddleValue = 2
+Instance.new("Leaf", defaults).LeafValue = 3
+chain:SetDefaults(defaults)
+
+local filtered = chain:Filter({excludeTags = {"Hidden"}})
+T.Pass("Filter excludes class with subclasses",
+	filtered:Class("Middle") == nil and filtered:Class("Leaf") ~= nil)
+T.Pass("Filter reparents subclasses to nearest kept ancestor",
+	filtered:Class("Leaf").Superclass == "Base" and filtered:Class("Base").Superclass == "<<<ROOT>>>")
+T.Pass("Filter leaves original superclasses unchanged",
+	chain:Class("Leaf").Superclass == "Middle")
+T.Pass("Filter keeps defaults of kept classes",
+	function()
+		local props = filtered:Defaults("Leaf")
+		return props.LeafValue == 3 and props.BaseValue == 1
+	end)
+T.Pass("Filter excludes defaults of excluded classes",
+	function()
+		return filtered:Defaults("Leaf").MiddleValue == nil and next(filtered:Defaults("Middle")) == nil
+	end)
+T.Pass("Filter does not modify original defaults",
+	function()
+		filtered:SetDefaults(nil)
+		return chain:Defaults("Leaf").MiddleValue == 2
+	end)
+
+T.Fail("Filter expects a table or function",
+	function() desc:Filter(42) end)
+T.Fail("Filter expects security to be a table",
+	function() desc:Filter({security = "None"}) end)
+T.Fail("Filter expects excludeTags to contain strings",
+	function() desc:Filter({excludeTags = {42}}) end)
+T.Fail("Filter propagates errors from predicate",
+	function() desc:Filter(function() error("fail") end) end)
@@ -0,0 +1,106 @@
+local desc = rbxmk.newDesc("RootDesc")
+
+local function newClass(name, superclass, ...)
+	local class = rbxmk.newDesc("ClassDesc")
+	class.Name = name
+	class.Superclass = superclass
+	for _, member in ipairs({...}) do
+		class:AddMember(member)
+	end
+	desc:AddClass(class)
+	return class
+end
+
+local function newProperty(name, category)
+	local prop = rbxmk.newDesc("PropertyDesc")
+	prop.Name = name
+	prop.ReadSecurity = category
+	return prop
+end
+
+local function names(array, field)
+	local list = {}
+	for i, v in ipairs(array) do
+		list[i] = v[field or "Name"]
+	end
+	return table.concat(list, ",")
+end
+
+-- Base
+--   Middle
+--     LeafA
+--     LeafB
+--   Other
+newClass("Base", "", newProperty("A", "Base"), newProperty("B", "Base"))
+newClass("Middle", "Base", newProperty("B", "Middle"), newProperty("C", "Middle"))
+newClass("LeafB", "Middle", newProperty("D", "LeafB"))
+newClass("LeafA", "Middle")
+newClass("Other", "Base")
+
+-- Members
+T.Pass("Members returns own members",
+	names(desc:Members("Middle")) == "B,C")
+T.Pass("Members with inherited false returns own members",
+	names(desc:Members("Middle", {inherited = false})) == "B,C")
+T.Pass("Members with inherited returns inherited members",
+	names(desc:Members("LeafB", {inherited = true})) == "A,B,C,D")
+T.Pass("nearer members override inherited members",
+	function()
+		for _, member in ipairs(desc:Members("LeafB", {inherited = true})) do
+			if member.Name == "B" then
+				return member.ReadSecurity == "Middle"
+			end
+		end
+		return false
+	end)
+T.Pass("Members of nonexistent class is empty",
+	#desc:Members("Foobar", {inherited = true}) == 0)
+T.Fail("Members expects a table for options",
+	function() desc:Members("Middle", true) end)
+T.Fail("inherited option must be a bool",
+	function() desc:Members("Middle", {inherited = 1}) end)
+T.Pass("inherited option error names the argument",
+	function()
+		local ok, err = pcall(desc.Members, desc, "Middle", {inherited = 1})
+		return not ok and string.find(err, "bad argument #3", 1, true) ~= nil
+	end)
+
+-- Superclasses
+T.Pass("Superclasses returns superclasses from nearest to furthest",
+	names(desc:Superclasses("LeafA")) == "Middle,Base")
+T.Pass("Superclasses of root class is empty",
+	#desc:Superclasses("Base") == 0)
+T.Pass("Superclasses of nonexistent class is empty",
+	#desc:Superclasses("Foobar") == 0)
+T.Pass("Superclasses returns ClassDescs",
+	desc:Superclasses("LeafA")[1] == desc:Class("Middle"))
+
+-- Subclasses
+T.Pass("Subclasses returns direct subclasses sorted by name",
+	names(desc:Subclasses("Base")) == "Middle,Other")
+T.Pass("recursive Subclasses returns all subclasses",
+	names(desc:Subclasses("Base", true)) == "Middle,LeafA,LeafB,Other")
+T.Pass("Subclasses of leaf class is empty",
+	#desc:Subclasses("LeafA", true) == 0)
+T.Fail("Subclasses expects a bool for recursive",
+	function() desc:Subclasses("Base", "true") end)
+
+-- IsA
+T.Pass("class IsA itself",
+	desc:IsA("LeafA", "LeafA"))
+T.Pass("class IsA superclass",
+	desc:IsA("LeafA", "Base"))
+T.Pass("class is not a subclass",
+	not desc:IsA("Base", "LeafA"))
+T.Pass("class is not a sibling",
+	not desc:IsA("LeafA", "Other"))
+T.Pass("nonexistent class is nothing",
+	not desc:IsA("Foobar", "Foobar"))
+
+-- Cycles
+newClass("CycleA", "CycleB")
+newClass("CycleB", "CycleA")
+T.Pass("Superclasses handles cycles",
+	names(desc:Superclasses("CycleA")) == "CycleB")
+T.Pass("Subclasses handles cycles",
+	names(desc:Subclasses("CycleA", true)) == "CycleB")
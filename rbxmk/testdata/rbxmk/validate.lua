@@ -0,0 +1,91 @@
+local desc = file.read(os.expand("$sd/../dump.desc.json"))
+
+local function find(problems, path, property)
+	for _, problem in ipairs(problems) do
+		if problem.Path == path and problem.Property == property then
+			return problem
+		end
+	end
+	return nil
+end
+
+local model = Instance.new("Model")
+model.Name = "Model"
+local part = Instance.new("Part", model)
+part.Name = "Part"
+part.Anchored = true
+local value = Instance.new("BoolValue", model)
+value.Name = "Value"
+value.Value = true
+
+T.Pass("validate returns empty list for valid tree",
+	function() return #rbxmk.validate(model, desc) == 0 end)
+T.Fail("validate expects an instance",
+	function() rbxmk.validate(nil, desc) end)
+T.Fail("validate requires a descriptor",
+	function() rbxmk.validate(model) end)
+T.Pass("validate uses descriptor of instance",
+	function()
+		model[sym.Desc] = desc
+		local problems = rbxmk.validate(model)
+		model[sym.Desc] = nil
+		return #problems == 0
+	end)
+
+local foo = Instance.new("Foobar", model)
+foo.Name = "Foo"
+local base = Instance.new("BasePart", model)
+base.Name = "Base"
+part.Foobar = true
+part.Anchored = 1
+part.Material = true
+value.Value = "true"
+
+local decoded = rbxmk.decodeFormat("rbxmx", [[
+<roblox version="4">
+	<Item class="Part">
+		<Properties>
+			<string name="Name">Decoded</string>
+			<token name="Shape">42</token>
+		</Properties>
+	</Item>
+</roblox>]]):GetChildren()[1]
+decoded.Parent = model
+
+local problems = rbxmk.validate(model, desc)
+T.Pass("validate reports each problem",
+	#problems == 7)
+T.Pass("validate reports nonexistent class",
+	find(problems, "Model.Foo", nil) ~= nil)
+T.Pass("validate reports uncreatable class",
+	find(problems, "Model.Base", nil) ~= nil)
+T.Pass("validate reports invalid member",
+	find(problems, "Model.Part", "Foobar") ~= nil)
+T.Pass("validate reports mismatched type",
+	find(problems, "Model.Part", "Anchored") ~= nil)
+T.Pass("validate reports invalid enum value",
+	find(problems, "Model.Decoded", "Shape") ~= nil)
+T.Pass("validate reports mismatched enum type",
+	find(problems, "Model.Part", "Material") ~= nil)
+T.Pass("validate reports problems in descendants",
+	find(problems, "Model.Value", "Value") ~= nil)
+T.Pass("problems have a message",
+	type(problems[1].Message) == "string")
+
+local game = DataModel.new()
+game.ExplicitAutoJoints = "true"
+local workspace = game:GetService("Workspace")
+Instance.new("Terrain", workspace).Name = "Terrain"
+Instance.new("Part", workspace).Name = "Part"
+local place = rbxmk.decodeFormat("rbxlx", rbxmk.encodeFormat("rbxlx", game))
+T.Pass("round-tripped place has metadata",
+	place.ExplicitAutoJoints ~= nil)
+T.Pass("validate accepts round-tripped place",
+	function() return #rbxmk.validate(place, desc) == 0 end)
+T.Pass("validate exempts services outside of a DataModel",
+	function()
+		local workspace = place:FindFirstChild("Workspace")
+		workspace.Parent = nil
+		workspace:FindFirstChild("Terrain").Parent = nil
+		return #rbxmk.validate(workspace, desc) == 0
+	end)
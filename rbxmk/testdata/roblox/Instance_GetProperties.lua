@@ -0,0 +1,56 @@
+local instance = Instance.new("BoolValue")
+instance.Name = "Value"
+instance.Value = true
+
+local props = instance:GetProperties()
+T.Pass("GetProperties returns a table",
+	type(props) == "table")
+T.Pass("GetProperties includes set properties",
+	props.Name == "Value" and props.Value == true)
+T.Pass("GetProperties does not include unset properties",
+	props.Parent == nil and props.Archivable == nil)
+T.Pass("GetProperties without descriptor ignores merge flag",
+	function() return instance:GetProperties(true).Archivable == nil end)
+T.Fail("GetProperties expects a bool for its first argument",
+	function() instance:GetProperties("foobar") end)
+
+local desc = file.read(os.expand("$sd/../dump.desc.json"))
+local Enum = desc:EnumTypes()
+local part = Instance.new("Part", nil, desc)
+part.Name = "Part"
+local props = part:GetProperties()
+T.Pass("GetProperties with descriptor includes only set properties",
+	function()
+		local n = 0
+		for _ in pairs(props) do n = n + 1 end
+		return n == 1 and props.Name == "Part"
+	end)
+
+local props = part:GetProperties(true)
+T.Pass("merged properties retain set values",
+	props.Name == "Part")
+T.Pass("merged properties include declared properties",
+	props.Anchored == false)
+T.Pass("merged properties include inherited properties",
+	props.Locked == false)
+T.Pass("merged properties have zero values",
+	props.Transparency == 0 and props.Size == Vector3.new(0, 0, 0))
+T.Pass("merged enum properties default to first enum item",
+	props.Material == Enum.Material.Plastic)
+T.Pass("merged properties exclude class references",
+	props.Parent == nil)
+
+part.Shape = 0
+T.Pass("set enum properties are enum items",
+	part:GetProperties().Shape == Enum.PartType.Ball)
+
+local defaults = desc:Copy()
+local instance = Instance.new("Instance")
+instance.Archivable = true
+defaults:SetDefaults(instance)
+part[sym.Desc] = defaults
+local props = part:GetProperties(true)
+T.Pass("merged properties use class defaults",
+	props.Archivable == true)
+T.Pass("merged properties use zero values without class defaults",
+	props.Anchored == false)
@@ -0,0 +1,39 @@
+local root = os.join(os.expand("$tmp"), "rbxmk_test_archive")
+os.remove(root, true)
+os.mkdir(root)
+
+local model = Instance.new("Model")
+Instance.new("Part", model)
+
+for _, lib in ipairs({{zip, "bundle.zip"}, {tar, "bundle.tar.gz"}}) do
+	local archive, name = lib[1], os.join(root, lib[2])
+	archive.write(name, {
+		["models/sword.rbxm"] = model,
+		["readme.txt"] = "A sword.",
+	})
+
+	local entries = archive.list(name)
+	T.Pass(name .. ": list enumerates entries",
+		#entries == 2 and entries[1].Path == "models/sword.rbxm" and entries[1].Name == "sword.rbxm" and entries[2].Path == "readme.txt")
+	T.Pass(name .. ": list includes entry info",
+		not entries[2].IsDir and entries[2].Size == 8 and entries[2].ModTime > 0)
+
+	local sword = archive.read(name, "models/sword.rbxm")
+	T.Pass(name .. ": read decodes entry by extension",
+		sword.Name == "sword" and sword:GetChildren()[1].ClassName == "Model" and sword:GetChildren()[1]:GetChildren()[1].ClassName == "Part")
+	T.Pass(name .. ": read decodes with format",
+		archive.read(name, "readme.txt", "txt") == "A sword.")
+
+	rbxmk.writeSource(lib[1] == zip and "zip" or "tar", rbxmk.encodeFormat("txt", "Updated."), name, "readme.txt")
+	T.Pass(name .. ": writeSource replaces entry",
+		rbxmk.decodeFormat("txt", rbxmk.readSource(lib[1] == zip and "zip" or "tar", name, "readme.txt")) == "Updated.")
+	T.Pass(name .. ": writeSource retains other entries",
+		#archive.list(name) == 2)
+
+	T.Fail(name .. ": read fails for missing entry",
+		function() archive.read(name, "missing.rbxm") end)
+	T.Fail(name .. ": write fails for unknown format",
+		function() archive.write(name, {["file.unknown"] = "content"}) end)
+end
+
+os.remove(root, true)
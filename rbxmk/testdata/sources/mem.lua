@@ -0,0 +1,43 @@
+T.Pass("stat returns nil for missing file",
+	mem.stat("missing.txt") == nil)
+T.Fail("read fails for missing file",
+	function() mem.read("missing.txt") end)
+
+mem.write("out/staged.txt", "staged")
+T.Pass("read returns written content",
+	mem.read("out/staged.txt") == "staged")
+T.Pass("relative paths are relative to the root",
+	mem.read("/out/staged.txt") == "staged")
+T.Pass("write does not touch disk",
+	os.stat("out/staged.txt") == nil)
+T.Pass("write creates parent directories",
+	function()
+		local info = mem.stat("out")
+		return info.Name == "out" and info.IsDir
+	end)
+T.Pass("stat returns info of file",
+	function()
+		local info = mem.stat("out/staged.txt")
+		return info.Name == "staged.txt" and not info.IsDir and info.Size == 6 and info.ModTime > 0
+	end)
+
+mem.write("out/a.txt", "a")
+mem.write("out/sub/b.txt", "b")
+T.Pass("dir lists files in directory",
+	function()
+		local files = mem.dir("out")
+		return #files == 3 and files[1].Name == "a.txt" and files[2].Name == "staged.txt" and files[3].Name == "sub" and files[3].IsDir
+	end)
+T.Fail("dir fails for file",
+	function() mem.dir("out/a.txt") end)
+T.Fail("write fails for directory",
+	function() mem.write("out/sub", "content", "txt") end)
+
+local model = Instance.new("Model")
+mem.write("model.rbxm", model)
+T.Pass("read decodes by extension",
+	mem.read("model.rbxm"):GetChildren()[1].ClassName == "Model")
+
+rbxmk.writeSource("mem", rbxmk.encodeFormat("txt", "source"), "source.txt")
+T.Pass("readSource reads written source",
+	rbxmk.decodeFormat("txt", rbxmk.readSource("mem", "source.txt")) == "source")
@@ -0,0 +1,191 @@
+package rtypes
+
+import (
+	"sort"
+
+	"github.com/robloxapi/types"
+)
+
+// ConvertType tries to convert v to the type named t. Returns the converted
+// value and true if the conversion succeeded, or v and false otherwise.
+func ConvertType(t string, v types.Value) (nv types.Value, ok bool) {
+	if v.Type() == t {
+		return v, true
+	}
+	switch t {
+	case "int":
+		switch v := v.(type) {
+		case types.Intlike:
+			return types.Int(v.Intlike()), true
+		case types.Numberlike:
+			return types.Int(v.Numberlike()), true
+		}
+	case "int64":
+		switch v := v.(type) {
+		case types.Intlike:
+			return types.Int64(v.Intlike()), true
+		case types.Numberlike:
+			return types.Int64(v.Numberlike()), true
+		}
+	case "float":
+		switch v := v.(type) {
+		case types.Numberlike:
+			return types.Float(v.Numberlike()), true
+		case types.Intlike:
+			return types.Float(v.Intlike()), true
+		}
+	case "double":
+		switch v := v.(type) {
+		case types.Numberlike:
+			return types.Double(v.Numberlike()), true
+		case types.Intlike:
+			return types.Double(v.Intlike()), true
+		}
+	case "string":
+		if v, ok := v.(types.Stringlike); ok {
+			return types.String(v.Stringlike()), true
+		}
+	case "BinaryString":
+		if v, ok := v.(types.Stringlike); ok {
+			return types.BinaryString(v.Stringlike()), true
+		}
+	case "ProtectedString":
+		if v, ok := v.(types.Stringlike); ok {
+			return types.ProtectedString(v.Stringlike()), true
+		}
+	case "Content":
+		if v, ok := v.(types.Stringlike); ok {
+			return types.Content(v.Stringlike()), true
+		}
+	case "SharedString":
+		if v, ok := v.(types.Stringlike); ok {
+			return types.SharedString(v.Stringlike()), true
+		}
+	case "Color3":
+		if v, ok := v.(Color3uint8); ok {
+			return types.Color3(v), true
+		}
+	case "Color3uint8":
+		if v, ok := v.(types.Color3); ok {
+			return Color3uint8(v), true
+		}
+	}
+	return v, false
+}
+
+// NormalizeValue prepares the value of a property for saving. Returns nil if
+// the property of the class is described as not saveable. Otherwise, returns
+// value converted to the declared type of the property. The value is returned
+// unchanged if the property is not described, or if it cannot be converted.
+func (d *RootDesc) NormalizeValue(class, property string, value types.PropValue) types.PropValue {
+	propDesc := d.Property(class, property)
+	if propDesc == nil {
+		return value
+	}
+	if !propDesc.CanSave {
+		return nil
+	}
+	switch propDesc.ValueType.Category {
+	case "Class":
+		return value
+	case "Enum":
+		switch v := value.(type) {
+		case types.Intlike:
+			return types.Token(v.Intlike())
+		case types.Numberlike:
+			return types.Token(v.Numberlike())
+		}
+		return value
+	}
+	if v, ok := ConvertType(propDesc.ValueType.Name, value); ok {
+		if v, ok := v.(types.PropValue); ok {
+			return v
+		}
+	}
+	return value
+}
+
+// Normalize prepares inst and each of its descendants for saving, by applying
+// NormalizeValue to each property.
+func (d *RootDesc) Normalize(inst *Instance) {
+	d.normalize(inst)
+	for _, desc := range inst.Descendants() {
+		d.normalize(desc)
+	}
+}
+
+func (d *RootDesc) normalize(inst *Instance) {
+	for name, value := range inst.properties {
+		inst.Set(name, d.NormalizeValue(inst.ClassName, name, value))
+	}
+}
+
+// CoerceValue tries to convert the value of a property to the declared type of
+// the property. Numbers and strings are converted to tokens for enum
+// properties. The value is returned unchanged if the property is not described.
+// An error is returned if the value cannot be converted to a valid value of the
+// declared type.
+func (d *RootDesc) CoerceValue(class, property string, value types.PropValue) (types.PropValue, error) {
+	propDesc := d.Property(class, property)
+	if propDesc == nil {
+		return value, nil
+	}
+	switch propDesc.ValueType.Category {
+	case "Class":
+	case "Enum":
+		switch v := value.(type) {
+		case types.Token:
+		case types.Intlike:
+			value = types.Token(v.Intlike())
+		case types.Numberlike:
+			value = types.Token(v.Numberlike())
+		case types.Stringlike:
+			if enumDesc := d.Enums[propDesc.ValueType.Name]; enumDesc != nil {
+				if item := enumDesc.Items[v.Stringlike()]; item != nil {
+					value = types.Token(item.Value)
+				}
+			}
+		}
+	default:
+		if v, ok := ConvertType(propDesc.ValueType.Name, value); ok {
+			if v, ok := v.(types.PropValue); ok {
+				value = v
+			}
+		}
+	}
+	if err := d.CheckValue(propDesc.ValueType, value); err != nil {
+		return value, err
+	}
+	return value, nil
+}
+
+// Coerce applies CoerceValue to each property of inst and its descendants.
+// Values that cannot be coerced are left unchanged, and are returned as
+// problems.
+func (d *RootDesc) Coerce(inst *Instance) (problems []Problem) {
+	d.coerce(inst, &problems)
+	for _, desc := range inst.Descendants() {
+		d.coerce(desc, &problems)
+	}
+	return problems
+}
+
+func (d *RootDesc) coerce(inst *Instance, problems *[]Problem) {
+	names := make([]string, 0, len(inst.properties))
+	for name := range inst.properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value, err := d.CoerceValue(inst.ClassName, name, inst.properties[name])
+		if err != nil {
+			*problems = append(*problems, Problem{
+				Instance: inst,
+				Property: name,
+				Message:  err.Error(),
+			})
+			continue
+		}
+		inst.properties[name] = value
+	}
+}
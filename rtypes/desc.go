@@ -12,6 +12,8 @@ import (
 type RootDesc struct {
 	*rbxdump.Root
 	EnumTypes *Enums
+	// Defaults maps a class name to the default property values of the class.
+	Defaults map[string]map[string]types.PropValue
 }
 
 // Type returns a string identifying the type of the value.
@@ -52,6 +54,242 @@ func (d *RootDesc) Property(class, name string) *rbxdump.Property {
 	return nil
 }
 
+// Superclasses returns the superclasses of a class, ordered from the nearest
+// superclass to the furthest. Returns nil if the class does not exist.
+func (d *RootDesc) Superclasses(class string) (supers []*rbxdump.Class) {
+	classDesc := d.Classes[class]
+	if classDesc == nil {
+		return nil
+	}
+	visited := map[*rbxdump.Class]bool{classDesc: true}
+	for {
+		classDesc = d.Classes[classDesc.Superclass]
+		if classDesc == nil || visited[classDesc] {
+			break
+		}
+		visited[classDesc] = true
+		supers = append(supers, classDesc)
+	}
+	return supers
+}
+
+// Subclasses returns the classes that inherit directly from a class, sorted by
+// name. If recursive is true, then the subclasses of each subclass are also
+// included, following their superclass.
+func (d *RootDesc) Subclasses(class string, recursive bool) (subs []*rbxdump.Class) {
+	children := map[string][]*rbxdump.Class{}
+	for _, classDesc := range d.Classes {
+		children[classDesc.Superclass] = append(children[classDesc.Superclass], classDesc)
+	}
+	for _, classes := range children {
+		sort.Slice(classes, func(i, j int) bool {
+			return classes[i].Name < classes[j].Name
+		})
+	}
+	visited := map[string]bool{class: true}
+	var walk func(class string)
+	walk = func(class string) {
+		for _, classDesc := range children[class] {
+			if visited[classDesc.Name] {
+				continue
+			}
+			visited[classDesc.Name] = true
+			subs = append(subs, classDesc)
+			if recursive {
+				walk(classDesc.Name)
+			}
+		}
+	}
+	walk(class)
+	return subs
+}
+
+// IsA returns whether a class is the same as super, or inherits from super.
+// Returns false if the class does not exist.
+func (d *RootDesc) IsA(class, super string) bool {
+	if d.Classes[class] == nil {
+		return false
+	}
+	if class == super {
+		return true
+	}
+	for _, classDesc := range d.Superclasses(class) {
+		if classDesc.Name == super {
+			return true
+		}
+	}
+	return false
+}
+
+// Members returns the members of a class, sorted by name. If inherited is true,
+// then the members of superclasses are also included, except for those that
+// are overridden by a member of the same name in a nearer class. Returns nil if
+// the class does not exist.
+func (d *RootDesc) Members(class string, inherited bool) (members []rbxdump.Member) {
+	classDesc := d.Classes[class]
+	if classDesc == nil {
+		return nil
+	}
+	classes := []*rbxdump.Class{classDesc}
+	if inherited {
+		classes = append(classes, d.Superclasses(class)...)
+	}
+	names := map[string]bool{}
+	for _, classDesc := range classes {
+		for name, member := range classDesc.Members {
+			if names[name] {
+				continue
+			}
+			names[name] = true
+			members = append(members, member)
+		}
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].MemberName() < members[j].MemberName()
+	})
+	return members
+}
+
+// SetDefaults sets the default property values of classes from a list of
+// instances. The properties of each instance become the defaults of the class
+// matching the ClassName of the instance. Properties that refer to instances
+// are ignored. Any previous defaults are discarded.
+func (d *RootDesc) SetDefaults(insts []*Instance) {
+	d.Defaults = make(map[string]map[string]types.PropValue, len(insts))
+	for _, inst := range insts {
+		props := d.Defaults[inst.ClassName]
+		if props == nil {
+			props = make(map[string]types.PropValue, len(inst.properties))
+			d.Defaults[inst.ClassName] = props
+		}
+		for name, value := range inst.properties {
+			if _, ok := value.(*Instance); ok {
+				continue
+			}
+			props[name] = value.Copy()
+		}
+	}
+}
+
+// ClassDefaults returns a copy of the default property values of a class,
+// including defaults inherited from superclasses. Defaults of a class take
+// precedence over those of its superclasses. Returns nil if there are no
+// defaults for the class.
+func (d *RootDesc) ClassDefaults(class string) map[string]types.PropValue {
+	if len(d.Defaults) == 0 {
+		return nil
+	}
+	chain := []string{class}
+	for _, classDesc := range d.Superclasses(class) {
+		chain = append(chain, classDesc.Name)
+	}
+	var defaults map[string]types.PropValue
+	for i := len(chain) - 1; i >= 0; i-- {
+		props, ok := d.Defaults[chain[i]]
+		if !ok {
+			continue
+		}
+		if defaults == nil {
+			defaults = make(map[string]types.PropValue, len(props))
+		}
+		for name, value := range props {
+			defaults[name] = value.Copy()
+		}
+	}
+	return defaults
+}
+
+// copyProps returns a copy of props, with each value copied.
+func copyProps(props map[string]types.PropValue) map[string]types.PropValue {
+	c := make(map[string]types.PropValue, len(props))
+	for name, value := range props {
+		c[name] = value.Copy()
+	}
+	return c
+}
+
+// ZeroValue returns the zero value of a property of the given type, or nil if
+// the type has no zero value. The zero value of an enum type is the first item
+// of the enum, or a token of value 0 if the enum does not exist.
+func (d *RootDesc) ZeroValue(typ rbxdump.Type) types.PropValue {
+	switch typ.Category {
+	case "Class":
+		return nil
+	case "Enum":
+		if enumDesc := d.Enums[typ.Name]; enumDesc != nil {
+			if items := enumDesc.GetEnumItems(); len(items) > 0 {
+				return types.Token(items[0].Value)
+			}
+		}
+		return types.Token(0)
+	}
+	switch typ.Name {
+	case "bool":
+		return types.False
+	case "int":
+		return types.Int(0)
+	case "int64":
+		return types.Int64(0)
+	case "float":
+		return types.Float(0)
+	case "double":
+		return types.Double(0)
+	case "string":
+		return types.String("")
+	case "BinaryString":
+		return types.BinaryString("")
+	case "ProtectedString":
+		return types.ProtectedString("")
+	case "Content":
+		return types.Content("")
+	case "SharedString":
+		return types.SharedString("")
+	case "Axes":
+		return types.Axes{}
+	case "BrickColor":
+		return types.NewBrickColor(194)
+	case "CFrame":
+		return types.NewCFrame()
+	case "Color3":
+		return types.Color3{}
+	case "Color3uint8":
+		return Color3uint8{}
+	case "ColorSequence":
+		return types.ColorSequence{
+			{Time: 0, Value: types.Color3{R: 1, G: 1, B: 1}},
+			{Time: 1, Value: types.Color3{R: 1, G: 1, B: 1}},
+		}
+	case "Faces":
+		return types.Faces{}
+	case "NumberRange":
+		return types.NumberRange{}
+	case "NumberSequence":
+		return types.NumberSequence{
+			{Time: 0, Value: 0},
+			{Time: 1, Value: 0},
+		}
+	case "PhysicalProperties":
+		return types.PhysicalProperties{}
+	case "Ray":
+		return types.Ray{}
+	case "Rect":
+		return types.Rect{}
+	case "UDim":
+		return types.UDim{}
+	case "UDim2":
+		return types.UDim2{}
+	case "Vector2":
+		return types.Vector2{}
+	case "Vector2int16":
+		return types.Vector2int16{}
+	case "Vector3":
+		return types.Vector3{}
+	case "Vector3int16":
+		return types.Vector3int16{}
+	}
+	return nil
+}
+
 // GenerateEnumTypes sets EnumTypes to a collection of enum values generated
 // from the root's enum descriptors.
 func (d *RootDesc) GenerateEnumTypes() {
@@ -0,0 +1,183 @@
+package rtypes
+
+import (
+	"reflect"
+
+	"github.com/robloxapi/rbxdump"
+	"github.com/robloxapi/types"
+)
+
+// tagsEqual returns whether a and b contain the same tags, in any order.
+func tagsEqual(a, b []string) bool {
+	set := make(map[string]int, len(a))
+	for _, tag := range a {
+		set[tag]++
+	}
+	for _, tag := range b {
+		set[tag]--
+	}
+	for _, n := range set {
+		if n != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// elementEqual returns whether the fields of a and b are equal. Tags are
+// compared without regard to order, and an empty list of parameters is equal to
+// no parameters.
+func elementEqual(a, b rbxdump.Fielder) bool {
+	fa, fb := a.Fields(), b.Fields()
+	ta, _ := fa["Tags"].(rbxdump.Tags)
+	tb, _ := fb["Tags"].(rbxdump.Tags)
+	for _, f := range []rbxdump.Fields{fa, fb} {
+		delete(f, "Tags")
+		if params, ok := f["Parameters"].([]rbxdump.Parameter); ok && len(params) == 0 {
+			f["Parameters"] = []rbxdump.Parameter(nil)
+		}
+	}
+	return reflect.DeepEqual(fa, fb) && tagsEqual(ta, tb)
+}
+
+// memberEqual returns whether a and b are the same kind of member with equal
+// fields.
+func memberEqual(a, b rbxdump.Member) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.MemberType() == b.MemberType() && elementEqual(a, b)
+}
+
+// classEqual returns whether a and b have equal fields and equal members.
+func classEqual(a, b *rbxdump.Class) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !elementEqual(a, b) || len(a.Members) != len(b.Members) {
+		return false
+	}
+	for name, member := range a.Members {
+		if !memberEqual(member, b.Members[name]) {
+			return false
+		}
+	}
+	return true
+}
+
+// enumEqual returns whether a and b have equal fields and equal items.
+func enumEqual(a, b *rbxdump.Enum) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !elementEqual(a, b) || len(a.Items) != len(b.Items) {
+		return false
+	}
+	for name, item := range a.Items {
+		other := b.Items[name]
+		if item == nil || other == nil {
+			if item != other {
+				return false
+			}
+			continue
+		}
+		if !elementEqual(item, other) {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy returns a deep copy of the descriptor. Enum types are not copied, and
+// are regenerated as needed.
+func (d *RootDesc) Copy() *RootDesc {
+	c := &RootDesc{Root: d.Root.Copy()}
+	if d.Defaults != nil {
+		c.Defaults = make(map[string]map[string]types.PropValue, len(d.Defaults))
+		for class, props := range d.Defaults {
+			c.Defaults[class] = copyProps(props)
+		}
+	}
+	return c
+}
+
+// Equal returns whether d and o describe the same classes, members, enums, and
+// enum items. Defaults are not compared.
+func (d *RootDesc) Equal(o *RootDesc) bool {
+	if d == o {
+		return true
+	}
+	if d == nil || o == nil || len(d.Classes) != len(o.Classes) || len(d.Enums) != len(o.Enums) {
+		return false
+	}
+	for name, class := range d.Classes {
+		if !classEqual(class, o.Classes[name]) {
+			return false
+		}
+	}
+	for name, enum := range d.Enums {
+		if !enumEqual(enum, o.Enums[name]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy returns a deep copy of the descriptor, including its members.
+func (d ClassDesc) Copy() ClassDesc { return ClassDesc{Class: d.Class.Copy()} }
+
+// Equal returns whether d and o have equal fields and members.
+func (d ClassDesc) Equal(o ClassDesc) bool { return classEqual(d.Class, o.Class) }
+
+// Copy returns a deep copy of the descriptor.
+func (d PropertyDesc) Copy() PropertyDesc { return PropertyDesc{Property: d.Property.Copy()} }
+
+// Equal returns whether d and o have equal fields.
+func (d PropertyDesc) Equal(o PropertyDesc) bool { return memberEqual(d.Property, o.Property) }
+
+// Copy returns a deep copy of the descriptor.
+func (d FunctionDesc) Copy() FunctionDesc { return FunctionDesc{Function: d.Function.Copy()} }
+
+// Equal returns whether d and o have equal fields.
+func (d FunctionDesc) Equal(o FunctionDesc) bool { return memberEqual(d.Function, o.Function) }
+
+// Copy returns a deep copy of the descriptor.
+func (d EventDesc) Copy() EventDesc { return EventDesc{Event: d.Event.Copy()} }
+
+// Equal returns whether d and o have equal fields.
+func (d EventDesc) Equal(o EventDesc) bool { return memberEqual(d.Event, o.Event) }
+
+// Copy returns a deep copy of the descriptor.
+func (d CallbackDesc) Copy() CallbackDesc { return CallbackDesc{Callback: d.Callback.Copy()} }
+
+// Equal returns whether d and o have equal fields.
+func (d CallbackDesc) Equal(o CallbackDesc) bool { return memberEqual(d.Callback, o.Callback) }
+
+// Copy returns a copy of the descriptor.
+func (d ParameterDesc) Copy() ParameterDesc { return d }
+
+// Equal returns whether d and o have equal fields.
+func (d ParameterDesc) Equal(o ParameterDesc) bool { return d == o }
+
+// Copy returns a copy of the descriptor.
+func (d TypeDesc) Copy() TypeDesc { return d }
+
+// Equal returns whether d and o have equal fields.
+func (d TypeDesc) Equal(o TypeDesc) bool { return d == o }
+
+// Copy returns a deep copy of the descriptor, including its items.
+func (d EnumDesc) Copy() EnumDesc { return EnumDesc{Enum: d.Enum.Copy()} }
+
+// Equal returns whether d and o have equal fields and items.
+func (d EnumDesc) Equal(o EnumDesc) bool { return enumEqual(d.Enum, o.Enum) }
+
+// Copy returns a deep copy of the descriptor.
+func (d EnumItemDesc) Copy() EnumItemDesc { return EnumItemDesc{EnumItem: d.EnumItem.Copy()} }
+
+// Equal returns whether d and o have equal fields.
+func (d EnumItemDesc) Equal(o EnumItemDesc) bool {
+	if d.EnumItem == nil || o.EnumItem == nil {
+		return d.EnumItem == o.EnumItem
+	}
+	return elementEqual(d.EnumItem, o.EnumItem)
+}
@@ -0,0 +1,144 @@
+package rtypes
+
+import (
+	"github.com/robloxapi/rbxdump"
+	"github.com/robloxapi/types"
+)
+
+// DescFilter determines whether an element of a root descriptor is kept by
+// Filter. element is a ClassDesc, EnumDesc, member descriptor, or EnumItemDesc.
+// For members and enum items, parent is the containing ClassDesc or EnumDesc,
+// and is otherwise nil.
+type DescFilter func(element, parent types.Value) bool
+
+// Filter returns a copy of d that contains only the elements for which keep
+// returns true. Excluding a class or enum also excludes its members or items,
+// as well as its defaults. A class whose superclass is excluded is reparented
+// to its nearest ancestor that is kept. The elements passed to keep are copies,
+// and d is not modified.
+func (d *RootDesc) Filter(keep DescFilter) *RootDesc {
+	root := d.Root.Copy()
+	for name, class := range root.Classes {
+		parent := ClassDesc{Class: class}
+		if !keep(parent, nil) {
+			delete(root.Classes, name)
+			continue
+		}
+		for name, member := range class.Members {
+			if !keep(NewMemberDesc(member), parent) {
+				delete(class.Members, name)
+			}
+		}
+	}
+	for name, enum := range root.Enums {
+		parent := EnumDesc{Enum: enum}
+		if !keep(parent, nil) {
+			delete(root.Enums, name)
+			continue
+		}
+		for name, item := range enum.Items {
+			if !keep(EnumItemDesc{EnumItem: item}, parent) {
+				delete(enum.Items, name)
+			}
+		}
+	}
+	for _, class := range root.Classes {
+		class.Superclass = d.nearestKept(class.Superclass, root.Classes)
+	}
+	filtered := &RootDesc{Root: root}
+	if d.Defaults != nil {
+		filtered.Defaults = make(map[string]map[string]types.PropValue, len(d.Defaults))
+		for class, props := range d.Defaults {
+			if _, ok := root.Classes[class]; ok {
+				filtered.Defaults[class] = copyProps(props)
+			}
+		}
+	}
+	return filtered
+}
+
+// nearestKept returns the name of the nearest class in the inheritance chain of
+// d that starts at class and is contained in kept. If the chain leaves d before
+// reaching a kept class, then the name at which it leaves is returned.
+func (d *RootDesc) nearestKept(class string, kept map[string]*rbxdump.Class) string {
+	for i := 0; i <= len(d.Classes); i++ {
+		if _, ok := kept[class]; ok {
+			return class
+		}
+		classDesc, ok := d.Classes[class]
+		if !ok {
+			return class
+		}
+		class = classDesc.Superclass
+	}
+	return class
+}
+
+// FilterSecurity returns a DescFilter that keeps members with a security
+// context contained in security. A property is kept only if both its read and
+// write security are contained in security. Classes, enums, and enum items are
+// always kept.
+func FilterSecurity(security ...string) DescFilter {
+	allowed := make(map[string]bool, len(security))
+	for _, s := range security {
+		allowed[s] = true
+	}
+	return func(element, parent types.Value) bool {
+		switch element := element.(type) {
+		case PropertyDesc:
+			return allowed[element.ReadSecurity] && allowed[element.WriteSecurity]
+		case FunctionDesc:
+			return allowed[element.Security]
+		case EventDesc:
+			return allowed[element.Security]
+		case CallbackDesc:
+			return allowed[element.Security]
+		}
+		return true
+	}
+}
+
+// FilterTags returns a DescFilter that excludes any element that has at least
+// one of the given tags.
+func FilterTags(tags ...string) DescFilter {
+	return func(element, parent types.Value) bool {
+		var tagger interface{ GetTag(string) bool }
+		switch element := element.(type) {
+		case ClassDesc:
+			tagger = element.Class
+		case PropertyDesc:
+			tagger = element.Property
+		case FunctionDesc:
+			tagger = element.Function
+		case EventDesc:
+			tagger = element.Event
+		case CallbackDesc:
+			tagger = element.Callback
+		case EnumDesc:
+			tagger = element.Enum
+		case EnumItemDesc:
+			tagger = element.EnumItem
+		default:
+			return true
+		}
+		for _, tag := range tags {
+			if tagger.GetTag(tag) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// FilterAll returns a DescFilter that keeps an element only if each of the
+// given filters keeps the element.
+func FilterAll(filters ...DescFilter) DescFilter {
+	return func(element, parent types.Value) bool {
+		for _, filter := range filters {
+			if !filter(element, parent) {
+				return false
+			}
+		}
+		return true
+	}
+}
@@ -427,7 +427,8 @@ func (inst *Instance) SetName(name string) {
 }
 
 // GetFullName returns the "full" name of the instance, which is the combined
-// names of the instance and every ancestor, separated by a `.` character.
+// names of the instance and every ancestor, separated by a `.` character. The
+// full name of a DataModel is empty.
 func (inst *Instance) GetFullName() string {
 	// Note: Roblox's GetFullName stops at the first ancestor that is a
 	// ServiceProvider. Since recreating this behavior would require
@@ -439,6 +440,9 @@ func (inst *Instance) GetFullName() string {
 		names = append(names, object.Name())
 		object = object.Parent()
 	}
+	if len(names) == 0 {
+		return ""
+	}
 	full := make([]byte, 0, 64)
 	for i := len(names) - 1; i > 0; i-- {
 		full = append(full, []byte(names[i])...)
@@ -0,0 +1,151 @@
+package rtypes
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/robloxapi/rbxdump"
+	"github.com/robloxapi/types"
+)
+
+// MergeConflict determines how a conflict is resolved when merging root
+// descriptors.
+type MergeConflict int
+
+const (
+	// ConflictOverlay resolves a conflict by using the element of the overlay.
+	ConflictOverlay MergeConflict = iota
+	// ConflictBase resolves a conflict by keeping the element of the base.
+	ConflictBase
+	// ConflictError causes the merge to fail when a conflict occurs.
+	ConflictError
+)
+
+// fieldsEqual returns whether the fields of a and b are equal, excluding tags.
+func fieldsEqual(a, b rbxdump.Fielder) bool {
+	fa, fb := a.Fields(), b.Fields()
+	delete(fa, "Tags")
+	delete(fb, "Tags")
+	return reflect.DeepEqual(fa, fb)
+}
+
+// Merge returns a new root descriptor that combines the classes, members, enums,
+// enum items, and defaults of d and overlay. Neither d nor overlay are modified.
+//
+// An element that exists in only one descriptor is included as-is. Two elements
+// with the same name conflict if their fields differ, excluding tags, members,
+// and items, which are merged individually. A conflict is resolved according to
+// conflict. Tags of the two elements are always combined. Likewise, two
+// defaults for the same property of the same class conflict if their values
+// differ.
+func (d *RootDesc) Merge(overlay *RootDesc, conflict MergeConflict) (*RootDesc, error) {
+	root := d.Root.Copy()
+	if root.Classes == nil {
+		root.Classes = map[string]*rbxdump.Class{}
+	}
+	if root.Enums == nil {
+		root.Enums = map[string]*rbxdump.Enum{}
+	}
+
+	for _, oclass := range overlay.GetClasses() {
+		class, ok := root.Classes[oclass.Name]
+		if !ok {
+			root.Classes[oclass.Name] = oclass.Copy()
+			continue
+		}
+		if !fieldsEqual(class, oclass) {
+			switch conflict {
+			case ConflictOverlay:
+				class.Superclass = oclass.Superclass
+				class.MemoryCategory = oclass.MemoryCategory
+			case ConflictError:
+				return nil, fmt.Errorf("conflicting class %s", oclass.Name)
+			}
+		}
+		class.SetTag(oclass.GetTags()...)
+		if class.Members == nil {
+			class.Members = map[string]rbxdump.Member{}
+		}
+		for _, omember := range oclass.GetMembers() {
+			name := omember.MemberName()
+			member, ok := class.Members[name]
+			if !ok {
+				class.Members[name] = omember.MemberCopy()
+				continue
+			}
+			if !fieldsEqual(member, omember) {
+				switch conflict {
+				case ConflictOverlay:
+					tags := member.GetTags()
+					member = omember.MemberCopy()
+					member.SetTag(tags...)
+					class.Members[name] = member
+				case ConflictError:
+					return nil, fmt.Errorf("conflicting member %s.%s", oclass.Name, name)
+				}
+			}
+			member.SetTag(omember.GetTags()...)
+		}
+	}
+
+	for _, oenum := range overlay.GetEnums() {
+		enum, ok := root.Enums[oenum.Name]
+		if !ok {
+			root.Enums[oenum.Name] = oenum.Copy()
+			continue
+		}
+		enum.SetTag(oenum.GetTags()...)
+		if enum.Items == nil {
+			enum.Items = map[string]*rbxdump.EnumItem{}
+		}
+		for _, oitem := range oenum.GetEnumItems() {
+			item, ok := enum.Items[oitem.Name]
+			if !ok {
+				enum.Items[oitem.Name] = oitem.Copy()
+				continue
+			}
+			if !fieldsEqual(item, oitem) {
+				switch conflict {
+				case ConflictOverlay:
+					item.Value = oitem.Value
+					item.Index = oitem.Index
+				case ConflictError:
+					return nil, fmt.Errorf("conflicting enum item %s.%s", oenum.Name, oitem.Name)
+				}
+			}
+			item.SetTag(oitem.GetTags()...)
+		}
+	}
+
+	var defaults map[string]map[string]types.PropValue
+	if d.Defaults != nil || overlay.Defaults != nil {
+		defaults = make(map[string]map[string]types.PropValue, len(d.Defaults))
+		for class, props := range d.Defaults {
+			defaults[class] = copyProps(props)
+		}
+	}
+	for class, oprops := range overlay.Defaults {
+		props, ok := defaults[class]
+		if !ok {
+			defaults[class] = copyProps(oprops)
+			continue
+		}
+		for name, ovalue := range oprops {
+			value, ok := props[name]
+			if !ok {
+				props[name] = ovalue.Copy()
+				continue
+			}
+			if !reflect.DeepEqual(value, ovalue) {
+				switch conflict {
+				case ConflictOverlay:
+					props[name] = ovalue.Copy()
+				case ConflictError:
+					return nil, fmt.Errorf("conflicting default %s.%s", class, name)
+				}
+			}
+		}
+	}
+
+	return &RootDesc{Root: root, Defaults: defaults}, nil
+}
@@ -0,0 +1,148 @@
+package rtypes
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/robloxapi/rbxdump"
+	"github.com/robloxapi/types"
+)
+
+// Problem describes a way in which an instance does not conform to a root
+// descriptor.
+type Problem struct {
+	// Instance is the instance that has the problem.
+	Instance *Instance
+	// Property is the name of the property that has the problem, or an empty
+	// string if the problem is with the instance itself.
+	Property string
+	// Message describes the problem.
+	Message string
+}
+
+// ProblemArray returns a list of problems as an Array of Dictionaries. Each
+// Dictionary has a Path field set to the full name of the instance, a Message
+// field, and a Property field if the problem has a property.
+func ProblemArray(problems []Problem) Array {
+	array := make(Array, len(problems))
+	for i, problem := range problems {
+		dict := Dictionary{
+			"Path":    types.String(problem.Instance.GetFullName()),
+			"Message": types.String(problem.Message),
+		}
+		if problem.Property != "" {
+			dict["Property"] = types.String(problem.Property)
+		}
+		array[i] = dict
+	}
+	return array
+}
+
+// Validate checks inst and each of its descendants against the descriptor,
+// returning the problems that were found. The following are checked:
+//
+//   - The class of each instance exists.
+//   - The class of each instance is creatable, unless the class is a service.
+//     Trees under a DataModel are exempt, since decoded places and models
+//     contain instances that cannot be created, such as Terrain.
+//   - Each property exists on the class of the instance. The properties of a
+//     DataModel hold metadata, and are not checked.
+//   - The value of each property matches the declared type of the property.
+//   - The value of each enum property is a valid item of the enum.
+func (d *RootDesc) Validate(inst *Instance) (problems []Problem) {
+	root := inst
+	for root.Parent() != nil {
+		root = root.Parent()
+	}
+	creatable := !root.IsDataModel()
+	d.validate(inst, creatable, &problems)
+	for _, desc := range inst.Descendants() {
+		d.validate(desc, creatable, &problems)
+	}
+	return problems
+}
+
+// validate checks a single instance. If creatable is true, then the class of
+// the instance is checked for being creatable.
+func (d *RootDesc) validate(inst *Instance, creatable bool, problems *[]Problem) {
+	classDesc := d.Classes[inst.ClassName]
+	if classDesc == nil {
+		*problems = append(*problems, Problem{
+			Instance: inst,
+			Message:  fmt.Sprintf("class %q does not exist", inst.ClassName),
+		})
+		return
+	}
+	if creatable && classDesc.GetTag("NotCreatable") && !classDesc.GetTag("Service") {
+		*problems = append(*problems, Problem{
+			Instance: inst,
+			Message:  fmt.Sprintf("class %q is not creatable", inst.ClassName),
+		})
+	}
+	if inst.IsDataModel() {
+		return
+	}
+
+	names := make([]string, 0, len(inst.properties))
+	for name := range inst.properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		propDesc := d.Property(classDesc.Name, name)
+		if propDesc == nil {
+			*problems = append(*problems, Problem{
+				Instance: inst,
+				Property: name,
+				Message:  fmt.Sprintf("%s is not a valid member of %s", name, classDesc.Name),
+			})
+			continue
+		}
+		if err := d.CheckValue(propDesc.ValueType, inst.properties[name]); err != nil {
+			*problems = append(*problems, Problem{
+				Instance: inst,
+				Property: name,
+				Message:  err.Error(),
+			})
+		}
+	}
+}
+
+// CheckValue returns an error if value is not a valid value for a property of
+// the given type.
+func (d *RootDesc) CheckValue(typ rbxdump.Type, value types.PropValue) error {
+	switch typ.Category {
+	case "Class":
+		inst, ok := value.(*Instance)
+		if !ok {
+			return fmt.Errorf("value type %s is not an instance", value.Type())
+		}
+		if d.Classes[typ.Name] == nil {
+			return fmt.Errorf("no class descriptor %q", typ.Name)
+		}
+		if d.IsA(inst.ClassName, typ.Name) {
+			return nil
+		}
+		return fmt.Errorf("instance of class %s expected, got %s", typ.Name, inst.ClassName)
+	case "Enum":
+		token, ok := value.(types.Token)
+		if !ok {
+			return fmt.Errorf("value type %s is not a token", value.Type())
+		}
+		enumDesc := d.Enums[typ.Name]
+		if enumDesc == nil {
+			return fmt.Errorf("no enum descriptor %q", typ.Name)
+		}
+		for _, item := range enumDesc.Items {
+			if item.Value == int(token) {
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid value %d for enum %s", token, typ.Name)
+	default:
+		if a, b := value.Type(), typ.Name; a != b {
+			return fmt.Errorf("value type %s does not match property type %s", a, b)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,387 @@
+package sources
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/anaminus/rbxmk"
+	"github.com/anaminus/rbxmk/rtypes"
+	"github.com/robloxapi/types"
+	lua "github.com/yuin/gopher-lua"
+)
+
+// archiveEntry is a single file or directory within an archive.
+type archiveEntry struct {
+	// Name is the slash-separated path of the entry within the archive.
+	Name string
+	// IsDir indicates whether the entry is a directory.
+	IsDir bool
+	// ModTime is the modification time of the entry.
+	ModTime time.Time
+	// Data is the content of the entry.
+	Data []byte
+}
+
+// archiveKind implements the reading and writing of a kind of archive. Entries
+// are read into memory in their entirety.
+type archiveKind struct {
+	// Read reads the entries of the archive at path.
+	Read func(path string) ([]archiveEntry, error)
+	// Write writes entries as an archive to path.
+	Write func(path string, entries []archiveEntry) error
+}
+
+// cleanEntryName returns name as a clean, slash-separated, relative path.
+func cleanEntryName(name string) string {
+	return strings.TrimPrefix(path.Clean("/"+filepath.ToSlash(name)), "/")
+}
+
+// findEntry returns the index of the entry in entries with the given name, or
+// -1 if there is no such entry.
+func findEntry(entries []archiveEntry, name string) int {
+	name = cleanEntryName(name)
+	for i, entry := range entries {
+		if cleanEntryName(entry.Name) == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// readEntry returns the content of the entry of the archive at path.
+func readEntry(kind archiveKind, path, name string) (b []byte, err error) {
+	entries, err := kind.Read(path)
+	if err != nil {
+		return nil, err
+	}
+	i := findEntry(entries, name)
+	if i < 0 || entries[i].IsDir {
+		return nil, fmt.Errorf("no file %q in archive %s", name, filepath.Base(path))
+	}
+	return entries[i].Data, nil
+}
+
+// writeEntry sets the content of the entry of the archive at path, replacing
+// an existing entry of the same name. The archive is created if it does not
+// exist.
+func writeEntry(kind archiveKind, path, name string, b []byte) (err error) {
+	entries, err := kind.Read(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	entry := archiveEntry{Name: cleanEntryName(name), ModTime: time.Now(), Data: b}
+	if i := findEntry(entries, name); i >= 0 {
+		entries[i] = entry
+	} else {
+		entries = append(entries, entry)
+	}
+	return kind.Write(path, entries)
+}
+
+// zipArchive implements the zip archive.
+var zipArchive = archiveKind{
+	Read: func(path string) (entries []archiveEntry, err error) {
+		r, err := zip.OpenReader(path)
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		for _, f := range r.File {
+			entry := archiveEntry{
+				Name:    f.Name,
+				IsDir:   f.FileInfo().IsDir(),
+				ModTime: f.Modified,
+			}
+			if !entry.IsDir {
+				rc, err := f.Open()
+				if err != nil {
+					return nil, err
+				}
+				entry.Data, err = ioutil.ReadAll(rc)
+				rc.Close()
+				if err != nil {
+					return nil, err
+				}
+			}
+			entries = append(entries, entry)
+		}
+		return entries, nil
+	},
+	Write: func(path string, entries []archiveEntry) (err error) {
+		var buf bytes.Buffer
+		w := zip.NewWriter(&buf)
+		for _, entry := range entries {
+			header := &zip.FileHeader{
+				Name:     entry.Name,
+				Method:   zip.Deflate,
+				Modified: entry.ModTime,
+			}
+			if entry.IsDir {
+				header.Name = strings.TrimSuffix(header.Name, "/") + "/"
+				header.Method = zip.Store
+			}
+			f, err := w.CreateHeader(header)
+			if err != nil {
+				return err
+			}
+			if _, err := f.Write(entry.Data); err != nil {
+				return err
+			}
+		}
+		if err := w.Close(); err != nil {
+			return err
+		}
+		return ioutil.WriteFile(path, buf.Bytes(), 0666)
+	},
+}
+
+// isGzip returns whether the archive at path is compressed with gzip,
+// according to its extension.
+func isGzip(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".gz" || ext == ".tgz"
+}
+
+// tarArchive implements the tar archive, which is compressed with gzip if the
+// path has a .gz or .tgz extension.
+var tarArchive = archiveKind{
+	Read: func(path string) (entries []archiveEntry, err error) {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		var r io.Reader = f
+		if isGzip(path) {
+			gr, err := gzip.NewReader(f)
+			if err != nil {
+				return nil, err
+			}
+			defer gr.Close()
+			r = gr
+		}
+		tr := tar.NewReader(r)
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			entry := archiveEntry{
+				Name:    header.Name,
+				ModTime: header.ModTime,
+			}
+			switch header.Typeflag {
+			case tar.TypeDir:
+				entry.IsDir = true
+			case tar.TypeReg, tar.TypeRegA:
+				if entry.Data, err = ioutil.ReadAll(tr); err != nil {
+					return nil, err
+				}
+			default:
+				continue
+			}
+			entries = append(entries, entry)
+		}
+		return entries, nil
+	},
+	Write: func(path string, entries []archiveEntry) (err error) {
+		var buf bytes.Buffer
+		var w io.Writer = &buf
+		var gw *gzip.Writer
+		if isGzip(path) {
+			gw = gzip.NewWriter(&buf)
+			w = gw
+		}
+		tw := tar.NewWriter(w)
+		for _, entry := range entries {
+			header := &tar.Header{
+				Name:    entry.Name,
+				Mode:    0666,
+				Size:    int64(len(entry.Data)),
+				ModTime: entry.ModTime,
+			}
+			if entry.IsDir {
+				header.Typeflag = tar.TypeDir
+				header.Name = strings.TrimSuffix(header.Name, "/") + "/"
+				header.Mode = 0777
+				header.Size = 0
+			}
+			if err := tw.WriteHeader(header); err != nil {
+				return err
+			}
+			if _, err := tw.Write(entry.Data); err != nil {
+				return err
+			}
+		}
+		if err := tw.Close(); err != nil {
+			return err
+		}
+		if gw != nil {
+			if err := gw.Close(); err != nil {
+				return err
+			}
+		}
+		return ioutil.WriteFile(path, buf.Bytes(), 0666)
+	},
+}
+
+func init() { register(Zip) }
+func Zip() rbxmk.Source {
+	return archiveSource("zip", zipArchive)
+}
+
+func init() { register(Tar) }
+func Tar() rbxmk.Source {
+	return archiveSource("tar", tarArchive)
+}
+
+// archiveSource returns a source that accesses the entries of a kind of
+// archive.
+func archiveSource(name string, kind archiveKind) rbxmk.Source {
+	return rbxmk.Source{
+		Name: name,
+		Read: func(s rbxmk.State) (b []byte, err error) {
+			path := string(s.Pull(1, "string").(types.String))
+			entry := string(s.Pull(2, "string").(types.String))
+			return readEntry(kind, path, entry)
+		},
+		Write: func(s rbxmk.State, b []byte) (err error) {
+			path := string(s.Pull(1, "string").(types.String))
+			entry := string(s.Pull(2, "string").(types.String))
+			return writeEntry(kind, path, entry, b)
+		},
+		Library: rbxmk.Library{
+			Open: func(s rbxmk.State) *lua.LTable {
+				lib := s.L.CreateTable(0, 3)
+				lib.RawSetString("read", s.WrapFunc(func(s rbxmk.State) int {
+					return archiveRead(s, kind)
+				}))
+				lib.RawSetString("list", s.WrapFunc(func(s rbxmk.State) int {
+					return archiveList(s, kind)
+				}))
+				lib.RawSetString("write", s.WrapFunc(func(s rbxmk.State) int {
+					return archiveWrite(s, kind)
+				}))
+				return lib
+			},
+		},
+	}
+}
+
+func archiveRead(s rbxmk.State, kind archiveKind) int {
+	archive := string(s.Pull(1, "string").(types.String))
+	entry := string(s.Pull(2, "string").(types.String))
+	formatName := string(s.PullOpt(3, "string", types.String("")).(types.String))
+	options := s.PullFormatOptions(4)
+	if formatName == "" {
+		if formatName = s.Ext(entry); formatName == "" {
+			return s.RaiseError("unknown format from %s", filepath.Base(entry))
+		}
+	}
+
+	format := s.Format(formatName)
+	if format.Name == "" {
+		return s.RaiseError("unknown format %q", formatName)
+	}
+	if format.Decode == nil {
+		return s.RaiseError("cannot decode with format %s", format.Name)
+	}
+
+	b, err := readEntry(kind, archive, entry)
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
+	v, err := format.Decode(options, b)
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
+	setInstanceStem(s, v, cleanEntryName(entry))
+	if options.Problems != nil {
+		return s.Push(v) + s.Push(rtypes.ProblemArray(*options.Problems))
+	}
+	return s.Push(v)
+}
+
+func archiveList(s rbxmk.State, kind archiveKind) int {
+	archive := string(s.Pull(1, "string").(types.String))
+	entries, err := kind.Read(archive)
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
+	tentries := s.L.CreateTable(len(entries), 0)
+	for _, entry := range entries {
+		name := cleanEntryName(entry.Name)
+		tinfo := s.L.CreateTable(0, 5)
+		tinfo.RawSetString("Path", lua.LString(name))
+		tinfo.RawSetString("Name", lua.LString(path.Base(name)))
+		tinfo.RawSetString("IsDir", lua.LBool(entry.IsDir))
+		tinfo.RawSetString("Size", lua.LNumber(len(entry.Data)))
+		tinfo.RawSetString("ModTime", lua.LNumber(entry.ModTime.Unix()))
+		tentries.Append(tinfo)
+	}
+	s.L.Push(tentries)
+	return 1
+}
+
+func archiveWrite(s rbxmk.State, kind archiveKind) int {
+	archive := string(s.Pull(1, "string").(types.String))
+	values := s.L.CheckTable(2)
+	options := s.PullFormatOptions(3)
+
+	var names []string
+	var err error
+	values.ForEach(func(k, v lua.LValue) {
+		if name, ok := k.(lua.LString); ok {
+			names = append(names, string(name))
+		} else if err == nil {
+			err = fmt.Errorf("entry name must be a string")
+		}
+	})
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
+	sort.Strings(names)
+
+	now := time.Now()
+	entries := make([]archiveEntry, 0, len(names))
+	for _, name := range names {
+		formatName := s.Ext(name)
+		if formatName == "" {
+			return s.RaiseError("unknown format from %s", name)
+		}
+		format := s.Format(formatName)
+		if format.Name == "" {
+			return s.RaiseError("unknown format %q", formatName)
+		}
+		if format.Encode == nil {
+			return s.RaiseError("cannot encode with format %s", format.Name)
+		}
+		value, err := s.PullFrom("Variant", values.RawGetString(name))
+		if err != nil {
+			return s.RaiseError("entry %s: %s", name, err)
+		}
+		b, err := format.Encode(options, value)
+		if err != nil {
+			return s.RaiseError("entry %s: %s", name, err)
+		}
+		entries = append(entries, archiveEntry{Name: cleanEntryName(name), ModTime: now, Data: b})
+	}
+	if err := kind.Write(archive, entries); err != nil {
+		return s.RaiseError(err.Error())
+	}
+	return 0
+}
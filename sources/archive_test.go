@@ -0,0 +1,59 @@
+package sources
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestArchiveEntries(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rbxmk-archive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	kinds := []struct {
+		name string
+		kind archiveKind
+	}{
+		{"bundle.zip", zipArchive},
+		{"bundle.tar", tarArchive},
+		{"bundle.tar.gz", tarArchive},
+	}
+	for _, k := range kinds {
+		path := filepath.Join(dir, k.name)
+		if err := writeEntry(k.kind, path, "models/sword.rbxm", []byte("sword")); err != nil {
+			t.Fatalf("%s: unexpected error: %s", k.name, err)
+		}
+		if err := writeEntry(k.kind, path, "readme.txt", []byte("readme")); err != nil {
+			t.Fatalf("%s: unexpected error: %s", k.name, err)
+		}
+		if err := writeEntry(k.kind, path, "/models/sword.rbxm", []byte("sword2")); err != nil {
+			t.Fatalf("%s: unexpected error: %s", k.name, err)
+		}
+
+		entries, err := k.kind.Read(path)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", k.name, err)
+		}
+		if len(entries) != 2 || entries[0].Name != "models/sword.rbxm" || entries[1].Name != "readme.txt" {
+			t.Errorf("%s: unexpected entries %v", k.name, entries)
+		}
+		b, err := readEntry(k.kind, path, "models/sword.rbxm")
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", k.name, err)
+		}
+		if string(b) != "sword2" {
+			t.Errorf("%s: expected entry to be replaced, got %q", k.name, b)
+		}
+		if _, err := readEntry(k.kind, path, "missing.rbxm"); err == nil {
+			t.Errorf("%s: expected error for missing entry", k.name)
+		}
+	}
+
+	if b, err := ioutil.ReadFile(filepath.Join(dir, "bundle.tar.gz")); err != nil || len(b) < 2 || b[0] != 0x1f || b[1] != 0x8b {
+		t.Errorf("expected tar.gz archive to be compressed")
+	}
+}
@@ -0,0 +1,294 @@
+package sources
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/anaminus/rbxmk"
+	"github.com/anaminus/rbxmk/rtypes"
+	"github.com/robloxapi/types"
+	lua "github.com/yuin/gopher-lua"
+)
+
+const (
+	// assetEnvURL is the environment variable that, if set, overrides the base
+	// URL of every endpoint used by the asset source.
+	assetEnvURL = "RBXMK_ASSET_URL"
+	// assetEnvAPIKey is the environment variable containing an API key used to
+	// publish places.
+	assetEnvAPIKey = "RBXMK_ASSET_API_KEY"
+	// assetEnvCookie is the environment variable containing the value of the
+	// .ROBLOSECURITY cookie used to download and upload assets.
+	assetEnvCookie = "RBXMK_ASSET_COOKIE"
+)
+
+func init() { register(Asset) }
+func Asset() rbxmk.Source {
+	return rbxmk.Source{
+		Name: "asset",
+		Read: func(s rbxmk.State) (b []byte, err error) {
+			opt, err := pullAssetOptions(s, 1)
+			if err != nil {
+				return nil, err
+			}
+			return newAssetClient().Download(opt)
+		},
+		Write: func(s rbxmk.State, b []byte) (err error) {
+			opt, err := pullAssetOptions(s, 1)
+			if err != nil {
+				return err
+			}
+			return newAssetClient().Upload(opt, b)
+		},
+		Library: rbxmk.Library{
+			Open: func(s rbxmk.State) *lua.LTable {
+				lib := s.L.CreateTable(0, 2)
+				lib.RawSetString("read", s.WrapFunc(assetRead))
+				lib.RawSetString("write", s.WrapFunc(assetWrite))
+				return lib
+			},
+		},
+	}
+}
+
+// assetOptions specifies the asset to be downloaded or uploaded.
+type assetOptions struct {
+	// ID is the ID of the asset.
+	ID int64
+	// Universe is the ID of the universe containing the place to be published.
+	// When set, the place is uploaded with an API key rather than a cookie.
+	Universe int64
+	// Publish indicates whether an uploaded place is published, rather than
+	// only saved. Applies only when uploading with an API key.
+	Publish bool
+}
+
+// pullAssetOptions gets from s.L the options at n, which may be an asset ID or
+// a table of options.
+func pullAssetOptions(s rbxmk.State, n int) (opt assetOptions, err error) {
+	switch v := s.L.Get(n).(type) {
+	case lua.LNumber:
+		opt.ID = int64(v)
+	case *lua.LTable:
+		switch lv := v.RawGetString("id").(type) {
+		case lua.LNumber:
+			opt.ID = int64(lv)
+		default:
+			return opt, fmt.Errorf("field id: number expected")
+		}
+		switch lv := v.RawGetString("universe").(type) {
+		case *lua.LNilType:
+		case lua.LNumber:
+			opt.Universe = int64(lv)
+		default:
+			return opt, fmt.Errorf("field universe: number expected")
+		}
+		switch lv := v.RawGetString("publish").(type) {
+		case *lua.LNilType:
+		case lua.LBool:
+			opt.Publish = bool(lv)
+		default:
+			return opt, fmt.Errorf("field publish: bool expected")
+		}
+	default:
+		return opt, rbxmk.TypeError(s.L, n, "number or table")
+	}
+	return opt, nil
+}
+
+// assetClient makes requests to the Roblox web API to download and upload
+// assets.
+type assetClient struct {
+	// DeliveryURL is the base URL of the endpoint from which assets are
+	// downloaded.
+	DeliveryURL string
+	// DataURL is the base URL of the endpoint to which assets are uploaded
+	// with a cookie.
+	DataURL string
+	// CloudURL is the base URL of the endpoint to which places are published
+	// with an API key.
+	CloudURL string
+	// APIKey is the API key used to publish places.
+	APIKey string
+	// Cookie is the value of the .ROBLOSECURITY cookie.
+	Cookie string
+}
+
+// newAssetClient returns an assetClient configured from the environment.
+func newAssetClient() *assetClient {
+	c := &assetClient{
+		DeliveryURL: "https://assetdelivery.roblox.com",
+		DataURL:     "https://data.roblox.com",
+		CloudURL:    "https://apis.roblox.com",
+		APIKey:      os.Getenv(assetEnvAPIKey),
+		Cookie:      os.Getenv(assetEnvCookie),
+	}
+	if url := strings.TrimSuffix(os.Getenv(assetEnvURL), "/"); url != "" {
+		c.DeliveryURL = url
+		c.DataURL = url
+		c.CloudURL = url
+	}
+	return c
+}
+
+// headers returns headers that contain the cookie, if present.
+func (c *assetClient) headers() http.Header {
+	headers := http.Header{}
+	if c.Cookie != "" {
+		headers.Set("Cookie", ".ROBLOSECURITY="+c.Cookie)
+	}
+	return headers
+}
+
+// assetContentType returns the content type of the encoded asset b.
+func assetContentType(b []byte) string {
+	if bytes.HasPrefix(b, []byte("<roblox!")) {
+		return "application/octet-stream"
+	}
+	return "application/xml"
+}
+
+// Download returns the content of the asset.
+func (c *assetClient) Download(opt assetOptions) (b []byte, err error) {
+	resp, err := httpDo(httpOptions{
+		URL:     fmt.Sprintf("%s/v1/asset/?id=%d", c.DeliveryURL, opt.ID),
+		Headers: c.headers(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Success() {
+		return nil, fmt.Errorf("bad status: %d %s", resp.StatusCode, resp.StatusMessage)
+	}
+	return resp.Body, nil
+}
+
+// Upload uploads b as the content of the asset. If a universe is specified,
+// then the asset is published as a place with the API key. Otherwise, the
+// asset is uploaded as a model or place with the cookie.
+func (c *assetClient) Upload(opt assetOptions, b []byte) (err error) {
+	if opt.Universe != 0 {
+		if c.APIKey == "" {
+			return errors.New("no credentials: " + assetEnvAPIKey + " must be set to upload to a universe")
+		}
+		return c.publishPlace(opt, b)
+	}
+	if c.Cookie == "" {
+		if c.APIKey != "" {
+			return errors.New("universe must be specified to upload with an API key")
+		}
+		return errors.New("no credentials: " + assetEnvAPIKey + " or " + assetEnvCookie + " must be set")
+	}
+	return c.uploadAsset(opt, b)
+}
+
+// publishPlace uploads a place with an API key.
+func (c *assetClient) publishPlace(opt assetOptions, b []byte) (err error) {
+	versionType := "Saved"
+	if opt.Publish {
+		versionType = "Published"
+	}
+	headers := http.Header{}
+	headers.Set("X-API-Key", c.APIKey)
+	headers.Set("Content-Type", assetContentType(b))
+	resp, err := httpDo(httpOptions{
+		URL:     fmt.Sprintf("%s/universes/v1/%d/places/%d/versions?versionType=%s", c.CloudURL, opt.Universe, opt.ID, versionType),
+		Method:  "POST",
+		Headers: headers,
+		Body:    b,
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.Success() {
+		return fmt.Errorf("bad status: %d %s", resp.StatusCode, resp.StatusMessage)
+	}
+	return nil
+}
+
+// uploadAsset uploads an asset with a cookie. The request is repeated once if
+// the server responds with a CSRF token.
+func (c *assetClient) uploadAsset(opt assetOptions, b []byte) (err error) {
+	headers := c.headers()
+	headers.Set("Content-Type", assetContentType(b))
+	headers.Set("User-Agent", "Roblox/WinInet")
+	req := httpOptions{
+		URL:     fmt.Sprintf("%s/Data/Upload.ashx?assetid=%d", c.DataURL, opt.ID),
+		Method:  "POST",
+		Headers: headers,
+		Body:    b,
+	}
+	resp, err := httpDo(req)
+	if err != nil {
+		return err
+	}
+	if token := resp.Headers.Get("X-CSRF-Token"); resp.StatusCode == http.StatusForbidden && token != "" {
+		headers.Set("X-CSRF-Token", token)
+		if resp, err = httpDo(req); err != nil {
+			return err
+		}
+	}
+	if !resp.Success() {
+		return fmt.Errorf("bad status: %d %s", resp.StatusCode, resp.StatusMessage)
+	}
+	return nil
+}
+
+func assetRead(s rbxmk.State) int {
+	opt, err := pullAssetOptions(s, 1)
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
+	formatName := string(s.Pull(2, "string").(types.String))
+	options := s.PullFormatOptions(3)
+	format := s.Format(formatName)
+	if format.Name == "" {
+		return s.RaiseError("unknown format %q", formatName)
+	}
+	if format.Decode == nil {
+		return s.RaiseError("cannot decode with format %s", format.Name)
+	}
+
+	b, err := newAssetClient().Download(opt)
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
+	v, err := format.Decode(options, b)
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
+	if options.Problems != nil {
+		return s.Push(v) + s.Push(rtypes.ProblemArray(*options.Problems))
+	}
+	return s.Push(v)
+}
+
+func assetWrite(s rbxmk.State) int {
+	opt, err := pullAssetOptions(s, 1)
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
+	formatName := string(s.Pull(2, "string").(types.String))
+	value := s.Pull(3, "Variant")
+	options := s.PullFormatOptions(4)
+	format := s.Format(formatName)
+	if format.Name == "" {
+		return s.RaiseError("unknown format %q", formatName)
+	}
+	if format.Encode == nil {
+		return s.RaiseError("cannot encode with format %s", format.Name)
+	}
+
+	b, err := format.Encode(options, value)
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
+	if err := newAssetClient().Upload(opt, b); err != nil {
+		return s.RaiseError(err.Error())
+	}
+	return 0
+}
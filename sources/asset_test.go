@@ -0,0 +1,120 @@
+package sources
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+// fakeAssetServer implements the endpoints used by assetClient.
+type fakeAssetServer struct {
+	assets   map[string][]byte
+	versions map[string]string
+}
+
+func (f *fakeAssetServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == "GET" && r.URL.Path == "/v1/asset/":
+		b, ok := f.assets[r.URL.Query().Get("id")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(b)
+	case r.Method == "POST" && r.URL.Path == "/Data/Upload.ashx":
+		if r.Header.Get("Cookie") != ".ROBLOSECURITY=cookie" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Header.Get("X-CSRF-Token") != "token" {
+			w.Header().Set("X-CSRF-Token", "token")
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		b, _ := ioutil.ReadAll(r.Body)
+		f.assets[r.URL.Query().Get("assetid")] = b
+	case r.Method == "POST" && r.URL.Path == "/universes/v1/1/places/2/versions":
+		if r.Header.Get("X-API-Key") != "key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		b, _ := ioutil.ReadAll(r.Body)
+		f.assets["2"] = b
+		f.versions["2"] = r.URL.Query().Get("versionType")
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestAssetClient(t *testing.T) {
+	fake := &fakeAssetServer{
+		assets:   map[string][]byte{"1234": []byte("model")},
+		versions: map[string]string{},
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	os.Setenv(assetEnvURL, server.URL)
+	defer os.Unsetenv(assetEnvURL)
+	client := newAssetClient()
+	if client.DeliveryURL != server.URL || client.DataURL != server.URL || client.CloudURL != server.URL {
+		t.Fatalf("expected base URL to be set from environment")
+	}
+
+	b, err := client.Download(assetOptions{ID: 1234})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if string(b) != "model" {
+		t.Errorf("unexpected asset content %q", b)
+	}
+	if _, err := client.Download(assetOptions{ID: 5}); err == nil {
+		t.Errorf("expected error for missing asset")
+	}
+
+	if err := client.Upload(assetOptions{ID: 1234}, []byte("new")); err == nil {
+		t.Errorf("expected error without credentials")
+	}
+
+	client.Cookie = "cookie"
+	if err := client.Upload(assetOptions{ID: 1234}, []byte("<roblox!new")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if b := fake.assets["1234"]; string(b) != "<roblox!new" {
+		t.Errorf("expected asset to be uploaded with cookie, got %q", b)
+	}
+	if b, _ := client.Download(assetOptions{ID: 1234}); string(b) != "<roblox!new" {
+		t.Errorf("expected uploaded asset to be downloaded, got %q", b)
+	}
+
+	if err := client.Upload(assetOptions{ID: 2, Universe: 1}, []byte("place")); err == nil {
+		t.Errorf("expected error for universe without API key")
+	}
+
+	client.APIKey = "key"
+	if err := client.Upload(assetOptions{ID: 1234}, []byte("<roblox!model")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if b := fake.assets["1234"]; string(b) != "<roblox!model" {
+		t.Errorf("expected model to be uploaded with cookie when API key is set, got %q", b)
+	}
+	if err := client.Upload(assetOptions{ID: 2, Universe: 1, Publish: true}, []byte("place")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if b := fake.assets["2"]; string(b) != "place" || fake.versions["2"] != "Published" {
+		t.Errorf("expected place to be published with API key, got %q as %q", b, fake.versions["2"])
+	}
+	if err := client.Upload(assetOptions{ID: 2, Universe: 1}, []byte("place")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if fake.versions["2"] != "Saved" {
+		t.Errorf("expected place to be saved, got %q", fake.versions["2"])
+	}
+
+	client.Cookie = ""
+	if err := client.Upload(assetOptions{ID: 1234}, []byte("<roblox!model")); err == nil {
+		t.Errorf("expected error without universe or cookie")
+	}
+}
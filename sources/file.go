@@ -1,7 +1,6 @@
 package sources
 
 import (
-	"io/ioutil"
 	"path/filepath"
 
 	"github.com/anaminus/rbxmk"
@@ -16,11 +15,11 @@ func File() rbxmk.Source {
 		Name: "file",
 		Read: func(s rbxmk.State) (b []byte, err error) {
 			path := string(s.Pull(1, "string").(types.String))
-			return ioutil.ReadFile(path)
+			return s.FS().ReadFile(path)
 		},
 		Write: func(s rbxmk.State, b []byte) (err error) {
 			path := string(s.Pull(1, "string").(types.String))
-			return ioutil.WriteFile(path, b, 0666)
+			return s.FS().WriteFile(path, b, 0666)
 		},
 		Library: rbxmk.Library{
 			Open: func(s rbxmk.State) *lua.LTable {
@@ -34,8 +33,19 @@ func File() rbxmk.Source {
 }
 
 func fileRead(s rbxmk.State) int {
+	return readFS(s, s.FS())
+}
+
+func fileWrite(s rbxmk.State) int {
+	return writeFS(s, s.FS())
+}
+
+// readFS implements the read function of a library for a source that accesses
+// files through fs.
+func readFS(s rbxmk.State, fs rbxmk.FS) int {
 	fileName := string(s.Pull(1, "string").(types.String))
 	formatName := string(s.PullOpt(2, "string", types.String("")).(types.String))
+	options := s.PullFormatOptions(3)
 	if formatName == "" {
 		if formatName = s.Ext(fileName); formatName == "" {
 			return s.RaiseError("unknown format from %s", filepath.Base(fileName))
@@ -50,30 +60,44 @@ func fileRead(s rbxmk.State) int {
 		return s.RaiseError("cannot decode with format %s", format.Name)
 	}
 
-	b, err := ioutil.ReadFile(fileName)
+	b, err := fs.ReadFile(fileName)
 	if err != nil {
 		return s.RaiseError(err.Error())
 	}
-	v, err := format.Decode(rbxmk.FormatOptions{}, b)
+	v, err := format.Decode(options, b)
 	if err != nil {
 		return s.RaiseError(err.Error())
 	}
-	if inst, ok := v.(*rtypes.Instance); ok {
-		ext := s.Ext(fileName)
-		if ext != "" && ext != "." {
-			ext = "." + ext
-		}
-		stem := filepath.Base(fileName)
-		stem = stem[:len(stem)-len(ext)]
-		inst.SetName(stem)
+	setInstanceStem(s, v, fileName)
+	if options.Problems != nil {
+		return s.Push(v) + s.Push(rtypes.ProblemArray(*options.Problems))
 	}
 	return s.Push(v)
 }
 
-func fileWrite(s rbxmk.State) int {
+// setInstanceStem sets the Name of v to the stem of fileName, if v is an
+// Instance. The stem is the base of fileName without the extension of a format.
+func setInstanceStem(s rbxmk.State, v types.Value, fileName string) {
+	inst, ok := v.(*rtypes.Instance)
+	if !ok {
+		return
+	}
+	ext := s.Ext(fileName)
+	if ext != "" && ext != "." {
+		ext = "." + ext
+	}
+	stem := filepath.Base(fileName)
+	stem = stem[:len(stem)-len(ext)]
+	inst.SetName(stem)
+}
+
+// writeFS implements the write function of a library for a source that
+// accesses files through fs.
+func writeFS(s rbxmk.State, fs rbxmk.FS) int {
 	fileName := string(s.Pull(1, "string").(types.String))
 	value := s.Pull(2, "Variant")
 	formatName := string(s.PullOpt(3, "string", types.String("")).(types.String))
+	options := s.PullFormatOptions(4)
 	if formatName == "" {
 		if formatName = s.Ext(fileName); formatName == "" {
 			return s.RaiseError("unknown format from %s", filepath.Base(fileName))
@@ -88,11 +112,11 @@ func fileWrite(s rbxmk.State) int {
 		return s.RaiseError("cannot encode with format %s", format.Name)
 	}
 
-	b, err := format.Encode(rbxmk.FormatOptions{}, value)
+	b, err := format.Encode(options, value)
 	if err != nil {
 		return s.RaiseError(err.Error())
 	}
-	if err := ioutil.WriteFile(fileName, b, 0666); err != nil {
+	if err := fs.WriteFile(fileName, b, 0666); err != nil {
 		return s.RaiseError(err.Error())
 	}
 	return 0
@@ -0,0 +1,94 @@
+package sources
+
+import (
+	"bytes"
+	"errors"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/anaminus/rbxmk"
+	"github.com/anaminus/rbxmk/rtypes"
+	"github.com/robloxapi/types"
+	lua "github.com/yuin/gopher-lua"
+)
+
+func init() { register(Git) }
+func Git() rbxmk.Source {
+	return rbxmk.Source{
+		Name: "git",
+		Read: func(s rbxmk.State) (b []byte, err error) {
+			repo := string(s.Pull(1, "string").(types.String))
+			ref := string(s.Pull(2, "string").(types.String))
+			file := string(s.Pull(3, "string").(types.String))
+			return gitReadFile(repo, ref, file)
+		},
+		Library: rbxmk.Library{
+			Open: func(s rbxmk.State) *lua.LTable {
+				lib := s.L.CreateTable(0, 1)
+				lib.RawSetString("read", s.WrapFunc(gitRead))
+				return lib
+			},
+		},
+	}
+}
+
+// gitReadFile returns the content of file at revision ref of the repository
+// located at repo. file is relative to the root of the repository. The local
+// git binary is used to read the file without checking out the revision.
+func gitReadFile(repo, ref, file string) (b []byte, err error) {
+	if ref == "" {
+		return nil, errors.New("revision must not be empty")
+	}
+	if strings.HasPrefix(ref, "-") {
+		return nil, errors.New("revision must not start with '-'")
+	}
+	file = strings.TrimPrefix(path.Clean("/"+filepath.ToSlash(file)), "/")
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("git", "-C", repo, "cat-file", "blob", ref+":"+file)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, errors.New(msg)
+		}
+		return nil, err
+	}
+	return stdout.Bytes(), nil
+}
+
+func gitRead(s rbxmk.State) int {
+	repo := string(s.Pull(1, "string").(types.String))
+	ref := string(s.Pull(2, "string").(types.String))
+	file := string(s.Pull(3, "string").(types.String))
+	formatName := string(s.PullOpt(4, "string", types.String("")).(types.String))
+	options := s.PullFormatOptions(5)
+	if formatName == "" {
+		if formatName = s.Ext(file); formatName == "" {
+			return s.RaiseError("unknown format from %s", filepath.Base(file))
+		}
+	}
+
+	format := s.Format(formatName)
+	if format.Name == "" {
+		return s.RaiseError("unknown format %q", formatName)
+	}
+	if format.Decode == nil {
+		return s.RaiseError("cannot decode with format %s", format.Name)
+	}
+
+	b, err := gitReadFile(repo, ref, file)
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
+	v, err := format.Decode(options, b)
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
+	setInstanceStem(s, v, file)
+	if options.Problems != nil {
+		return s.Push(v) + s.Push(rtypes.ProblemArray(*options.Problems))
+	}
+	return s.Push(v)
+}
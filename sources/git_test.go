@@ -0,0 +1,72 @@
+package sources
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestGitReadFile(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	repo, err := ioutil.TempDir("", "rbxmk-git")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(repo)
+
+	git := func(args ...string) {
+		args = append([]string{"-C", repo, "-c", "user.name=rbxmk", "-c", "user.email=rbxmk@example.com"}, args...)
+		if b, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %s: %s", args, err, b)
+		}
+	}
+	write := func(content string) {
+		if err := os.MkdirAll(filepath.Join(repo, "src"), 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(repo, "src", "Main.lua"), []byte(content), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+	git("init", "-q")
+	write("first")
+	git("add", "-A")
+	git("commit", "-q", "-m", "first")
+	write("second")
+	git("commit", "-q", "-a", "-m", "second")
+	write("uncommitted")
+
+	for _, test := range []struct {
+		ref, file, content string
+	}{
+		{"HEAD", "src/Main.lua", "second"},
+		{"HEAD~1", "src/Main.lua", "first"},
+		{"HEAD~1", "/src/Main.lua", "first"},
+	} {
+		b, err := gitReadFile(repo, test.ref, test.file)
+		if err != nil {
+			t.Errorf("%s:%s: unexpected error: %s", test.ref, test.file, err)
+			continue
+		}
+		if string(b) != test.content {
+			t.Errorf("%s:%s: expected %q, got %q", test.ref, test.file, test.content, b)
+		}
+	}
+
+	if _, err := gitReadFile(repo, "HEAD", "src/Missing.lua"); err == nil {
+		t.Errorf("expected error for missing file")
+	}
+	if _, err := gitReadFile(repo, "HEAD~5", "src/Main.lua"); err == nil {
+		t.Errorf("expected error for missing revision")
+	}
+	if _, err := gitReadFile(repo, "", "src/Main.lua"); err == nil {
+		t.Errorf("expected error for empty revision")
+	}
+	if _, err := gitReadFile(repo, "--output=injected", "src/Main.lua"); err == nil {
+		t.Errorf("expected error for revision starting with '-'")
+	}
+}
@@ -3,10 +3,14 @@ package sources
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/anaminus/rbxmk"
+	"github.com/anaminus/rbxmk/rtypes"
 	"github.com/robloxapi/types"
 	lua "github.com/yuin/gopher-lua"
 )
@@ -16,8 +20,11 @@ func HTTP() rbxmk.Source {
 	return rbxmk.Source{
 		Name: "http",
 		Read: func(s rbxmk.State) (b []byte, err error) {
-			url := string(s.Pull(1, "string").(types.String))
-			return httpGet(url)
+			opt, err := pullHTTPGetOptions(s, 1)
+			if err != nil {
+				return nil, err
+			}
+			return httpGet(opt)
 		},
 		Write: func(s rbxmk.State, b []byte) (err error) {
 			url := string(s.Pull(1, "string").(types.String))
@@ -25,25 +32,28 @@ func HTTP() rbxmk.Source {
 		},
 		Library: rbxmk.Library{
 			Open: func(s rbxmk.State) *lua.LTable {
-				lib := s.L.CreateTable(0, 2)
+				lib := s.L.CreateTable(0, 3)
 				lib.RawSetString("read", s.WrapFunc(httpRead))
 				lib.RawSetString("write", s.WrapFunc(httpWrite))
+				lib.RawSetString("request", s.WrapFunc(httpRequest))
 				return lib
 			},
 		},
 	}
 }
 
-func httpGet(url string) (b []byte, err error) {
-	resp, err := http.Get(url)
+// httpGet makes a GET request according to opt, returning the body of the
+// response. Unlike httpDo, a response with a non-2XX status is an error.
+func httpGet(opt httpOptions) (b []byte, err error) {
+	opt.Method = "GET"
+	resp, err := httpDo(opt)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("bad status: %s", resp.Status)
+	if !resp.Success() {
+		return nil, fmt.Errorf("bad status: %d %s", resp.StatusCode, resp.StatusMessage)
 	}
-	return ioutil.ReadAll(resp.Body)
+	return resp.Body, nil
 }
 
 func httpPost(url string, b []byte) (err error) {
@@ -58,9 +68,159 @@ func httpPost(url string, b []byte) (err error) {
 	return nil
 }
 
+// httpOptions specifies a request to be made by httpDo.
+type httpOptions struct {
+	// URL is the location to which the request is made.
+	URL string
+	// Method is the HTTP method of the request. Defaults to GET.
+	Method string
+	// Headers contains the headers to be sent with the request.
+	Headers http.Header
+	// Body is the body of the request. No body is sent if nil.
+	Body []byte
+	// Timeout is the maximum duration of the request. A value of zero means
+	// no timeout.
+	Timeout time.Duration
+	// Retries is the number of times the request is retried after a network
+	// error or a 5XX status. Only requests with an idempotent method are
+	// retried, unless RetryAll is set.
+	Retries int
+	// RetryAll indicates whether requests with a method that is not
+	// idempotent, such as POST, are also retried.
+	RetryAll bool
+	// Backoff is the delay before the first retry. The delay doubles with
+	// each subsequent retry. Defaults to httpDefaultBackoff.
+	Backoff time.Duration
+	// CacheDir is the directory in which the responses of GET requests are
+	// cached. Responses are not cached if empty.
+	CacheDir string
+}
+
+// httpDefaultBackoff is the delay before the first retry of a request when no
+// backoff is specified.
+const httpDefaultBackoff = time.Second
+
+// httpResponse contains the result of a request made by httpDo.
+type httpResponse struct {
+	// StatusCode is the status code of the response.
+	StatusCode int
+	// StatusMessage is the status of the response, without the status code.
+	StatusMessage string
+	// Headers contains the headers of the response.
+	Headers http.Header
+	// Body is the body of the response.
+	Body []byte
+}
+
+// Success returns whether the status code of the response is 2XX.
+func (r *httpResponse) Success() bool {
+	return 200 <= r.StatusCode && r.StatusCode < 300
+}
+
+// httpSend makes a single request according to opt. If cache is not nil, then
+// the request is made conditional on the cached response being outdated.
+func httpSend(client *http.Client, method string, opt httpOptions, cache *httpCacheEntry) (r *httpResponse, err error) {
+	var body io.Reader
+	if opt.Body != nil {
+		body = bytes.NewReader(opt.Body)
+	}
+	req, err := http.NewRequest(method, opt.URL, body)
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range opt.Headers {
+		req.Header[name] = values
+	}
+	if cache != nil {
+		if etag := cache.Headers.Get("ETag"); etag != "" && req.Header.Get("If-None-Match") == "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if mod := cache.Headers.Get("Last-Modified"); mod != "" && req.Header.Get("If-Modified-Since") == "" {
+			req.Header.Set("If-Modified-Since", mod)
+		}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &httpResponse{
+		StatusCode:    resp.StatusCode,
+		StatusMessage: strings.TrimSpace(strings.TrimPrefix(resp.Status, fmt.Sprint(resp.StatusCode))),
+		Headers:       resp.Header,
+		Body:          b,
+	}, nil
+}
+
+// httpIdempotent returns whether a request with method can be repeated without
+// additional effects.
+func httpIdempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "TRACE", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
+
+// httpDo makes a request according to opt. Unlike httpGet and httpPost, a
+// response with a non-2XX status is not an error.
+//
+// The request is retried according to opt.Retries. If opt.CacheDir is set,
+// then a GET request is made conditional on the cached response, which is
+// returned if the server responds that it has not been modified.
+func httpDo(opt httpOptions) (r *httpResponse, err error) {
+	method := opt.Method
+	if method == "" {
+		method = "GET"
+	}
+	var cache *httpCacheEntry
+	if opt.CacheDir != "" && method == "GET" {
+		if cache, err = readHTTPCache(opt.CacheDir, opt.URL); err != nil {
+			return nil, err
+		}
+	}
+	backoff := opt.Backoff
+	if backoff <= 0 {
+		backoff = httpDefaultBackoff
+	}
+	retries := opt.Retries
+	if !opt.RetryAll && !httpIdempotent(method) {
+		retries = 0
+	}
+	client := &http.Client{Timeout: opt.Timeout}
+	for attempt := 0; ; attempt++ {
+		r, err = httpSend(client, method, opt, cache)
+		if attempt < retries && (err != nil || r.StatusCode >= 500) {
+			time.Sleep(backoff << attempt)
+			continue
+		}
+		break
+	}
+	if err != nil {
+		return nil, err
+	}
+	if cache != nil && r.StatusCode == http.StatusNotModified {
+		return cache.Response(), nil
+	}
+	if opt.CacheDir != "" && method == "GET" && r.StatusCode == http.StatusOK {
+		if err := writeHTTPCache(opt.CacheDir, opt.URL, r); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
 func httpRead(s rbxmk.State) int {
-	url := string(s.Pull(1, "string").(types.String))
+	opt, err := pullHTTPGetOptions(s, 1)
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
 	formatName := string(s.PullOpt(2, "string", types.String("")).(types.String))
+	options := s.PullFormatOptions(3)
 	format := s.Format(formatName)
 	if format.Name == "" {
 		return s.RaiseError("unknown format %q", formatName)
@@ -69,14 +229,17 @@ func httpRead(s rbxmk.State) int {
 		return s.RaiseError("cannot decode with format %s", format.Name)
 	}
 
-	b, err := httpGet(url)
+	b, err := httpGet(opt)
 	if err != nil {
 		return s.RaiseError(err.Error())
 	}
-	v, err := format.Decode(rbxmk.FormatOptions{}, b)
+	v, err := format.Decode(options, b)
 	if err != nil {
 		return s.RaiseError(err.Error())
 	}
+	if options.Problems != nil {
+		return s.Push(v) + s.Push(rtypes.ProblemArray(*options.Problems))
+	}
 	return s.Push(v)
 }
 
@@ -84,6 +247,7 @@ func httpWrite(s rbxmk.State) int {
 	url := string(s.Pull(1, "string").(types.String))
 	formatName := string(s.Pull(2, "string").(types.String))
 	value := s.Pull(3, "Variant")
+	options := s.PullFormatOptions(4)
 	format := s.Format(formatName)
 	if format.Name == "" {
 		return s.RaiseError("unknown format %q", formatName)
@@ -92,7 +256,7 @@ func httpWrite(s rbxmk.State) int {
 		return s.RaiseError("cannot encode with format %s", format.Name)
 	}
 
-	b, err := format.Encode(rbxmk.FormatOptions{}, value)
+	b, err := format.Encode(options, value)
 	if err != nil {
 		return s.RaiseError(err.Error())
 	}
@@ -101,3 +265,190 @@ func httpWrite(s rbxmk.State) int {
 	}
 	return 0
 }
+
+// pullHTTPHeaders gets a table of headers from lv. Each field maps a header
+// name to a string or an array of strings.
+func pullHTTPHeaders(s rbxmk.State, lv lua.LValue) (headers http.Header, err error) {
+	table, ok := lv.(*lua.LTable)
+	if !ok {
+		return nil, fmt.Errorf("table expected")
+	}
+	headers = http.Header{}
+	table.ForEach(func(k, v lua.LValue) {
+		if err != nil {
+			return
+		}
+		name, ok := k.(lua.LString)
+		if !ok {
+			err = fmt.Errorf("string expected for header name")
+			return
+		}
+		switch v := v.(type) {
+		case lua.LString:
+			headers.Add(string(name), string(v))
+		case *lua.LTable:
+			for i := 1; i <= v.Len(); i++ {
+				value, ok := v.RawGetInt(i).(lua.LString)
+				if !ok {
+					err = fmt.Errorf("header %s: string expected in array", name)
+					return
+				}
+				headers.Add(string(name), string(value))
+			}
+		default:
+			err = fmt.Errorf("header %s: string or table expected", name)
+		}
+	})
+	return headers, err
+}
+
+// pullHTTPOptions gets the options of a request from table. Fields related to
+// formats and the request body are not included.
+func pullHTTPOptions(s rbxmk.State, table *lua.LTable) (opt httpOptions, err error) {
+	switch lv := table.RawGetString("url").(type) {
+	case lua.LString:
+		opt.URL = string(lv)
+	default:
+		return opt, fmt.Errorf("field url: string expected")
+	}
+	switch lv := table.RawGetString("method").(type) {
+	case *lua.LNilType:
+	case lua.LString:
+		opt.Method = strings.ToUpper(string(lv))
+	default:
+		return opt, fmt.Errorf("field method: string expected")
+	}
+	if lv := table.RawGetString("headers"); lv != lua.LNil {
+		if opt.Headers, err = pullHTTPHeaders(s, lv); err != nil {
+			return opt, fmt.Errorf("field headers: %s", err)
+		}
+	}
+	switch lv := table.RawGetString("timeout").(type) {
+	case *lua.LNilType:
+	case lua.LNumber:
+		opt.Timeout = time.Duration(float64(lv) * float64(time.Second))
+	default:
+		return opt, fmt.Errorf("field timeout: number expected")
+	}
+	switch lv := table.RawGetString("retries").(type) {
+	case *lua.LNilType:
+	case lua.LNumber:
+		opt.Retries = int(lv)
+	default:
+		return opt, fmt.Errorf("field retries: number expected")
+	}
+	switch lv := table.RawGetString("retryAll").(type) {
+	case *lua.LNilType:
+	case lua.LBool:
+		opt.RetryAll = bool(lv)
+	default:
+		return opt, fmt.Errorf("field retryAll: bool expected")
+	}
+	switch lv := table.RawGetString("backoff").(type) {
+	case *lua.LNilType:
+	case lua.LNumber:
+		opt.Backoff = time.Duration(float64(lv) * float64(time.Second))
+	default:
+		return opt, fmt.Errorf("field backoff: number expected")
+	}
+	switch lv := table.RawGetString("cache").(type) {
+	case *lua.LNilType:
+	case lua.LString:
+		opt.CacheDir = string(lv)
+	default:
+		return opt, fmt.Errorf("field cache: string expected")
+	}
+	return opt, nil
+}
+
+// pullHTTPGetOptions gets from s.L the options at n of a GET request, which may
+// be a URL or a table of options.
+func pullHTTPGetOptions(s rbxmk.State, n int) (opt httpOptions, err error) {
+	switch v := s.L.Get(n).(type) {
+	case lua.LString:
+		opt.URL = string(v)
+	case *lua.LTable:
+		if opt, err = pullHTTPOptions(s, v); err != nil {
+			return opt, err
+		}
+	default:
+		rbxmk.TypeError(s.L, n, "string or table")
+		return opt, nil
+	}
+	opt.Method = "GET"
+	return opt, nil
+}
+
+func httpRequest(s rbxmk.State) int {
+	options, ok := s.L.Get(1).(*lua.LTable)
+	if !ok {
+		rbxmk.TypeError(s.L, 1, "table")
+		return 0
+	}
+	opt, err := pullHTTPOptions(s, options)
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
+
+	var format rbxmk.Format
+	switch lv := options.RawGetString("format").(type) {
+	case *lua.LNilType:
+	case lua.LString:
+		if format = s.Format(string(lv)); format.Name == "" {
+			return s.RaiseError("unknown format %q", string(lv))
+		}
+	default:
+		return s.RaiseError("field format: string expected")
+	}
+	formatOptions := s.PullFormatOptions(2)
+
+	if lv := options.RawGetString("body"); lv != lua.LNil {
+		if format.Name != "" {
+			if format.Encode == nil {
+				return s.RaiseError("cannot encode with format %s", format.Name)
+			}
+			v, err := s.PullFrom("Variant", lv)
+			if err != nil {
+				return s.RaiseError("field body: %s", err)
+			}
+			if opt.Body, err = format.Encode(formatOptions, v); err != nil {
+				return s.RaiseError(err.Error())
+			}
+		} else {
+			v, err := s.PullFrom("BinaryString", lv)
+			if err != nil {
+				return s.RaiseError("field body: %s", err)
+			}
+			opt.Body = []byte(v.(types.BinaryString))
+		}
+	}
+
+	resp, err := httpDo(opt)
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
+	headers := make(rtypes.Dictionary, len(resp.Headers))
+	for name, values := range resp.Headers {
+		headers[name] = types.String(strings.Join(values, ", "))
+	}
+	var body types.Value = types.BinaryString(resp.Body)
+	if format.Name != "" && resp.Success() {
+		if format.Decode == nil {
+			return s.RaiseError("cannot decode with format %s", format.Name)
+		}
+		if body, err = format.Decode(formatOptions, resp.Body); err != nil {
+			return s.RaiseError(err.Error())
+		}
+	}
+	response := rtypes.Dictionary{
+		"success":       types.Bool(resp.Success()),
+		"statusCode":    types.Int(resp.StatusCode),
+		"statusMessage": types.String(resp.StatusMessage),
+		"headers":       headers,
+		"body":          body,
+	}
+	if formatOptions.Problems != nil {
+		return s.Push(response) + s.Push(rtypes.ProblemArray(*formatOptions.Problems))
+	}
+	return s.Push(response)
+}
@@ -0,0 +1,169 @@
+package sources
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestHTTPDo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/echo":
+			b, _ := ioutil.ReadAll(r.Body)
+			w.Header().Set("X-Method", r.Method)
+			w.Header().Set("X-Auth", r.Header.Get("Authorization"))
+			w.WriteHeader(http.StatusCreated)
+			w.Write(b)
+		case "/slow":
+			time.Sleep(200 * time.Millisecond)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	resp, err := httpDo(httpOptions{
+		URL:     server.URL + "/echo",
+		Method:  "PUT",
+		Headers: http.Header{"Authorization": {"Bearer token"}},
+		Body:    []byte("hello"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.StatusCode != http.StatusCreated || resp.StatusMessage != "Created" {
+		t.Errorf("unexpected status: %d %q", resp.StatusCode, resp.StatusMessage)
+	}
+	if !resp.Success() {
+		t.Errorf("expected success")
+	}
+	if m := resp.Headers.Get("X-Method"); m != "PUT" {
+		t.Errorf("expected method PUT, got %q", m)
+	}
+	if a := resp.Headers.Get("X-Auth"); a != "Bearer token" {
+		t.Errorf("expected authorization header to be sent, got %q", a)
+	}
+	if string(resp.Body) != "hello" {
+		t.Errorf("expected body to be echoed, got %q", resp.Body)
+	}
+
+	resp, err = httpDo(httpOptions{URL: server.URL + "/missing"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.StatusCode != http.StatusNotFound || resp.Success() {
+		t.Errorf("expected unsuccessful status 404, got %d", resp.StatusCode)
+	}
+
+	if _, err = httpDo(httpOptions{URL: server.URL + "/slow", Timeout: 10 * time.Millisecond}); err == nil {
+		t.Errorf("expected timeout error")
+	}
+}
+
+func TestHTTPDoRetry(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	resp, err := httpDo(httpOptions{URL: server.URL, Retries: 1, Backoff: time.Millisecond})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.StatusCode != http.StatusServiceUnavailable || attempts != 2 {
+		t.Errorf("expected 2 failed attempts, got %d with status %d", attempts, resp.StatusCode)
+	}
+
+	attempts = 0
+	resp, err = httpDo(httpOptions{URL: server.URL, Retries: 3, Backoff: time.Millisecond})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !resp.Success() || string(resp.Body) != "ok" || attempts != 3 {
+		t.Errorf("expected success after 3 attempts, got %d with status %d", attempts, resp.StatusCode)
+	}
+
+	attempts = 0
+	resp, err = httpDo(httpOptions{URL: server.URL, Method: "POST", Retries: 3, Backoff: time.Millisecond})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.StatusCode != http.StatusServiceUnavailable || attempts != 1 {
+		t.Errorf("expected POST request to not be retried, got %d attempts", attempts)
+	}
+
+	attempts = 0
+	resp, err = httpDo(httpOptions{URL: server.URL, Method: "POST", Retries: 3, RetryAll: true, Backoff: time.Millisecond})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !resp.Success() || attempts != 3 {
+		t.Errorf("expected POST request to be retried with RetryAll, got %d attempts", attempts)
+	}
+
+	attempts = 0
+	b, err := httpGet(httpOptions{URL: server.URL, Retries: 3, Backoff: time.Millisecond})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if string(b) != "ok" || attempts != 3 {
+		t.Errorf("expected httpGet to retry, got %d attempts", attempts)
+	}
+
+	attempts = 0
+	if _, err := httpGet(httpOptions{URL: server.URL}); err == nil {
+		t.Errorf("expected httpGet to fail for 5XX status")
+	}
+}
+
+func TestHTTPDoCache(t *testing.T) {
+	const etag = `"v1"`
+	var requests, notModified int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == etag {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Write([]byte("content"))
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "rbxmk-http-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for i := 0; i < 2; i++ {
+		resp, err := httpDo(httpOptions{URL: server.URL + "/asset", CacheDir: dir})
+		if err != nil {
+			t.Fatalf("request %d: unexpected error: %s", i, err)
+		}
+		if resp.StatusCode != http.StatusOK || string(resp.Body) != "content" {
+			t.Errorf("request %d: expected cached content, got %d %q", i, resp.StatusCode, resp.Body)
+		}
+	}
+	if requests != 2 || notModified != 1 {
+		t.Errorf("expected second request to be conditional, got %d requests, %d not modified", requests, notModified)
+	}
+
+	if _, err := httpDo(httpOptions{URL: server.URL + "/asset", Method: "POST", CacheDir: dir}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if notModified != 1 {
+		t.Errorf("expected POST request to not use cache")
+	}
+}
@@ -0,0 +1,88 @@
+package sources
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// httpCacheEntry is a response stored in an HTTP cache directory. The metadata
+// of an entry is stored as JSON, and the body is stored in a separate file.
+type httpCacheEntry struct {
+	URL           string
+	StatusCode    int
+	StatusMessage string
+	Headers       http.Header
+	Body          []byte `json:"-"`
+}
+
+// Response returns the entry as a response.
+func (e *httpCacheEntry) Response() *httpResponse {
+	return &httpResponse{
+		StatusCode:    e.StatusCode,
+		StatusMessage: e.StatusMessage,
+		Headers:       e.Headers,
+		Body:          e.Body,
+	}
+}
+
+// httpCachePath returns the path within dir of the files for the entry of url.
+// The body is stored at the returned path, and the metadata is stored at the
+// path with a ".json" extension.
+func httpCachePath(dir, url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(dir, hex.EncodeToString(sum[:]))
+}
+
+// readHTTPCache returns the entry of url from dir. Returns nil if the entry
+// does not exist, or is incomplete.
+func readHTTPCache(dir, url string) (entry *httpCacheEntry, err error) {
+	path := httpCachePath(dir, url)
+	meta, err := ioutil.ReadFile(path + ".json")
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	entry = &httpCacheEntry{}
+	if err := json.Unmarshal(meta, entry); err != nil || entry.URL != url {
+		return nil, nil
+	}
+	if entry.Body, err = ioutil.ReadFile(path); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return entry, nil
+}
+
+// writeHTTPCache writes r as the entry of url to dir. The entry is written only
+// if r has an ETag or Last-Modified header with which it can be validated.
+func writeHTTPCache(dir, url string, r *httpResponse) error {
+	if r.Headers.Get("ETag") == "" && r.Headers.Get("Last-Modified") == "" {
+		return nil
+	}
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return err
+	}
+	meta, err := json.Marshal(httpCacheEntry{
+		URL:           url,
+		StatusCode:    r.StatusCode,
+		StatusMessage: r.StatusMessage,
+		Headers:       r.Headers,
+	})
+	if err != nil {
+		return err
+	}
+	path := httpCachePath(dir, url)
+	if err := ioutil.WriteFile(path, r.Body, 0666); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path+".json", meta, 0666)
+}
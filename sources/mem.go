@@ -0,0 +1,69 @@
+package sources
+
+import (
+	"os"
+
+	"github.com/anaminus/rbxmk"
+	"github.com/robloxapi/types"
+	lua "github.com/yuin/gopher-lua"
+)
+
+func init() { register(Mem) }
+func Mem() rbxmk.Source {
+	return rbxmk.Source{
+		Name: "mem",
+		Read: func(s rbxmk.State) (b []byte, err error) {
+			path := string(s.Pull(1, "string").(types.String))
+			return s.MemFS().ReadFile(path)
+		},
+		Write: func(s rbxmk.State, b []byte) (err error) {
+			path := string(s.Pull(1, "string").(types.String))
+			return s.MemFS().WriteFile(path, b, 0666)
+		},
+		Library: rbxmk.Library{
+			Open: func(s rbxmk.State) *lua.LTable {
+				lib := s.L.CreateTable(0, 4)
+				lib.RawSetString("read", s.WrapFunc(memRead))
+				lib.RawSetString("write", s.WrapFunc(memWrite))
+				lib.RawSetString("dir", s.WrapFunc(memDir))
+				lib.RawSetString("stat", s.WrapFunc(memStat))
+				return lib
+			},
+		},
+	}
+}
+
+func memRead(s rbxmk.State) int {
+	return readFS(s, s.MemFS())
+}
+
+func memWrite(s rbxmk.State) int {
+	return writeFS(s, s.MemFS())
+}
+
+func memDir(s rbxmk.State) int {
+	dirname := s.CheckString(1)
+	files, err := s.MemFS().ReadDir(dirname)
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
+	tfiles := s.L.CreateTable(len(files), 0)
+	for _, info := range files {
+		tfiles.Append(s.CreateFileInfoTable(info))
+	}
+	s.L.Push(tfiles)
+	return 1
+}
+
+func memStat(s rbxmk.State) int {
+	info, err := s.MemFS().Stat(s.CheckString(1))
+	if err != nil {
+		if os.IsNotExist(err) {
+			s.L.Push(lua.LNil)
+			return 1
+		}
+		return s.RaiseError(err.Error())
+	}
+	s.L.Push(s.CreateFileInfoTable(info))
+	return 1
+}
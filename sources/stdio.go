@@ -0,0 +1,79 @@
+package sources
+
+import (
+	"io/ioutil"
+
+	"github.com/anaminus/rbxmk"
+	"github.com/anaminus/rbxmk/rtypes"
+	"github.com/robloxapi/types"
+	lua "github.com/yuin/gopher-lua"
+)
+
+func init() { register(Stdio) }
+func Stdio() rbxmk.Source {
+	return rbxmk.Source{
+		Name: "stdio",
+		Read: func(s rbxmk.State) (b []byte, err error) {
+			return ioutil.ReadAll(s.Stdin())
+		},
+		Write: func(s rbxmk.State, b []byte) (err error) {
+			_, err = s.Stdout().Write(b)
+			return err
+		},
+		Library: rbxmk.Library{
+			Open: func(s rbxmk.State) *lua.LTable {
+				lib := s.L.CreateTable(0, 2)
+				lib.RawSetString("read", s.WrapFunc(stdioRead))
+				lib.RawSetString("write", s.WrapFunc(stdioWrite))
+				return lib
+			},
+		},
+	}
+}
+
+func stdioRead(s rbxmk.State) int {
+	formatName := string(s.Pull(1, "string").(types.String))
+	options := s.PullFormatOptions(2)
+	format := s.Format(formatName)
+	if format.Name == "" {
+		return s.RaiseError("unknown format %q", formatName)
+	}
+	if format.Decode == nil {
+		return s.RaiseError("cannot decode with format %s", format.Name)
+	}
+
+	b, err := ioutil.ReadAll(s.Stdin())
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
+	v, err := format.Decode(options, b)
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
+	if options.Problems != nil {
+		return s.Push(v) + s.Push(rtypes.ProblemArray(*options.Problems))
+	}
+	return s.Push(v)
+}
+
+func stdioWrite(s rbxmk.State) int {
+	value := s.Pull(1, "Variant")
+	formatName := string(s.Pull(2, "string").(types.String))
+	options := s.PullFormatOptions(3)
+	format := s.Format(formatName)
+	if format.Name == "" {
+		return s.RaiseError("unknown format %q", formatName)
+	}
+	if format.Encode == nil {
+		return s.RaiseError("cannot encode with format %s", format.Name)
+	}
+
+	b, err := format.Encode(options, value)
+	if err != nil {
+		return s.RaiseError(err.Error())
+	}
+	if _, err := s.Stdout().Write(b); err != nil {
+		return s.RaiseError(err.Error())
+	}
+	return 0
+}
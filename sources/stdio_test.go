@@ -0,0 +1,77 @@
+package sources
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/anaminus/rbxmk"
+	"github.com/robloxapi/types"
+	lua "github.com/yuin/gopher-lua"
+)
+
+// newStdioWorld returns a world with the stdio source, and a minimal string
+// type and format.
+func newStdioWorld(in string) (w *rbxmk.World, out *bytes.Buffer) {
+	w = rbxmk.NewWorld(lua.NewState(lua.Options{SkipOpenLibs: true}))
+	out = &bytes.Buffer{}
+	w.SetStd(strings.NewReader(in), out, nil)
+	pull := func(s rbxmk.State, r rbxmk.Reflector, lvs ...lua.LValue) (types.Value, error) {
+		if v, ok := lvs[0].(lua.LString); ok {
+			return types.String(v), nil
+		}
+		return nil, rbxmk.TypeError(nil, 0, "string")
+	}
+	push := func(s rbxmk.State, r rbxmk.Reflector, v types.Value) ([]lua.LValue, error) {
+		return []lua.LValue{lua.LString(v.(types.String))}, nil
+	}
+	w.RegisterReflector(rbxmk.Reflector{Name: "string", PushTo: push, PullFrom: pull})
+	w.RegisterReflector(rbxmk.Reflector{Name: "Variant", PushTo: push, PullFrom: pull})
+	w.RegisterFormat(rbxmk.Format{
+		Name: "upper",
+		Encode: func(opt rbxmk.FormatOptions, v types.Value) ([]byte, error) {
+			return []byte(strings.ToUpper(string(v.(types.String)))), nil
+		},
+		Decode: func(opt rbxmk.FormatOptions, b []byte) (types.Value, error) {
+			return types.String(strings.ToUpper(string(b))), nil
+		},
+	})
+	w.RegisterSource(Stdio())
+	return w, out
+}
+
+func TestStdioSource(t *testing.T) {
+	w, out := newStdioWorld("input")
+	s := rbxmk.State{World: w, L: w.State()}
+	source := w.Source("stdio")
+	b, err := source.Read(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if string(b) != "input" {
+		t.Errorf("expected stdin to be read, got %q", b)
+	}
+	if err := source.Write(s, []byte("output")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if out.String() != "output" {
+		t.Errorf("expected stdout to be written, got %q", out.String())
+	}
+}
+
+func TestStdioLibrary(t *testing.T) {
+	w, out := newStdioWorld("input")
+	if err := w.Open(rbxmk.Library{Name: "stdio", Open: Stdio().Library.Open}); err != nil {
+		t.Fatal(err)
+	}
+	err := w.DoString(`
+		local v = stdio.read("upper")
+		stdio.write(v .. "-output", "upper")
+	`, "test", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if out.String() != "INPUT-OUTPUT" {
+		t.Errorf("expected decoded input to be written, got %q", out.String())
+	}
+}
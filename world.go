@@ -2,6 +2,7 @@ package rbxmk
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"runtime"
 	"sort"
@@ -17,12 +18,19 @@ import (
 // World contains the entire state of a Lua environment, including a Lua state,
 // and registered Reflectors, Formats, and Sources.
 type World struct {
-	l          *lua.LState
-	fileStack  []FileInfo
-	reflectors map[string]Reflector
-	formats    map[string]Format
-	sources    map[string]Source
-	globalDesc *rtypes.RootDesc
+	l           *lua.LState
+	fileStack   []FileInfo
+	reflectors  map[string]Reflector
+	formats     map[string]Format
+	sources     map[string]Source
+	globalDesc  *rtypes.RootDesc
+	defaultDesc func() (*rtypes.RootDesc, error)
+	fs          FS
+	memFS       *MemFS
+	allowWrite  bool
+	stdin       io.Reader
+	stdout      io.Writer
+	stderr      io.Writer
 
 	udmut    sync.Mutex
 	userdata map[interface{}]uintptr
@@ -375,8 +383,28 @@ func (w *World) RegisterFormat(f Format) {
 
 // Format returns the Format registered with the given name. If the name is not
 // registered, then Format.Name will be an empty string.
+//
+// If the name is a registered format followed by the name of a registered
+// compression format, such as "rbxl.gz", then the returned format is the former
+// layered under the latter. Layers may be nested.
 func (w *World) Format(name string) Format {
-	return w.formats[strings.TrimPrefix(name, ".")]
+	name = strings.TrimPrefix(name, ".")
+	if format, ok := w.formats[name]; ok {
+		return format
+	}
+	i := strings.LastIndexByte(name, '.')
+	if i < 0 {
+		return Format{}
+	}
+	outer := w.formats[name[i+1:]]
+	if !outer.IsCompression() {
+		return Format{}
+	}
+	inner := w.Format(name[:i])
+	if inner.Name == "" {
+		return Format{}
+	}
+	return outer.layer(inner)
 }
 
 // Formats returns a list of registered formats.
@@ -392,7 +420,8 @@ func (w *World) Formats() []Format {
 }
 
 // Ext returns the extension of filename that most closely matches the name of a
-// registered format. Returns an empty string if no format was found.
+// registered format, including formats layered under a compression format.
+// Returns an empty string if no format was found.
 func (w *World) Ext(filename string) (ext string) {
 	i := len(filename) - 1
 	for ; i >= 0 && !os.IsPathSeparator(filename[i]); i-- {
@@ -632,3 +661,88 @@ func (w *World) Desc(inst *rtypes.Instance) *rtypes.RootDesc {
 func (w *World) SetDesc(root *rtypes.RootDesc) {
 	w.globalDesc = root
 }
+
+// DefaultDesc returns a root descriptor from the function set by
+// SetDefaultDesc. Returns an error if no function is set.
+func (w *World) DefaultDesc() (*rtypes.RootDesc, error) {
+	if w.defaultDesc == nil {
+		return nil, fmt.Errorf("no default descriptor")
+	}
+	return w.defaultDesc()
+}
+
+// SetDefaultDesc sets a function that returns a default root descriptor. The
+// function should return a separate descriptor each time it is called.
+func (w *World) SetDefaultDesc(f func() (*rtypes.RootDesc, error)) {
+	w.defaultDesc = f
+}
+
+// Stdin returns the standard input of the world, which defaults to os.Stdin.
+func (w *World) Stdin() io.Reader {
+	if w.stdin == nil {
+		return os.Stdin
+	}
+	return w.stdin
+}
+
+// Stdout returns the standard output of the world, which defaults to
+// os.Stdout.
+func (w *World) Stdout() io.Writer {
+	if w.stdout == nil {
+		return os.Stdout
+	}
+	return w.stdout
+}
+
+// Stderr returns the standard error of the world, which defaults to
+// os.Stderr.
+func (w *World) Stderr() io.Writer {
+	if w.stderr == nil {
+		return os.Stderr
+	}
+	return w.stderr
+}
+
+// SetStd sets the standard input, output, and error of the world. A nil value
+// resets the corresponding stream to its default.
+func (w *World) SetStd(in io.Reader, out, err io.Writer) {
+	w.stdin = in
+	w.stdout = out
+	w.stderr = err
+}
+
+// FS returns the filesystem through which files are accessed. Defaults to the
+// filesystem of the operating system.
+func (w *World) FS() FS {
+	if w.fs == nil {
+		return osFS{}
+	}
+	return w.fs
+}
+
+// SetFS sets the filesystem through which files are accessed. A nil value
+// resets the filesystem to its default.
+func (w *World) SetFS(fs FS) {
+	w.fs = fs
+}
+
+// AllowWrite returns whether libraries may expose functions that modify the
+// filesystem beyond writing files. Defaults to false.
+func (w *World) AllowWrite() bool {
+	return w.allowWrite
+}
+
+// SetAllowWrite sets whether libraries may expose functions that modify the
+// filesystem beyond writing files. Must be set before libraries are opened.
+func (w *World) SetAllowWrite(allow bool) {
+	w.allowWrite = allow
+}
+
+// MemFS returns the in-memory filesystem of the world, creating it if
+// necessary.
+func (w *World) MemFS() *MemFS {
+	if w.memFS == nil {
+		w.memFS = NewMemFS()
+	}
+	return w.memFS
+}